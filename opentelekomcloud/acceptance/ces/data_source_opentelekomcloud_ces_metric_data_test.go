@@ -0,0 +1,60 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+func TestCESMetricDataSources_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCESMetricDataSourcesBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_ces_metrics.ecs", "metrics.#"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_ces_metric_data.cpu", "filter", "max"),
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_ces_metric_data.cpu", "count"),
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_ces_metric_data.cpu", "average"),
+				),
+			},
+		},
+	})
+}
+
+var testCESMetricDataSourcesBasic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm_1" {
+  name = "instance_1"
+  network {
+    uuid = "%s"
+  }
+}
+
+data "opentelekomcloud_ces_metrics" "ecs" {
+  namespace = "SYS.ECS"
+
+  dimensions {
+    name  = "instance_id"
+    value = opentelekomcloud_compute_instance_v2.vm_1.id
+  }
+}
+
+data "opentelekomcloud_ces_metric_data" "cpu" {
+  namespace   = "SYS.ECS"
+  metric_name = "cpu_util"
+  period      = 300
+  filter      = "max"
+  duration    = "1h"
+
+  dimensions {
+    name  = "instance_id"
+    value = opentelekomcloud_compute_instance_v2.vm_1.id
+  }
+}
+`, env.OS_NETWORK_ID)
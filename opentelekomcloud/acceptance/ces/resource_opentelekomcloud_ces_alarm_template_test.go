@@ -0,0 +1,115 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceAlarmTemplateName = "opentelekomcloud_ces_alarm_template.template_1"
+
+func TestCESAlarmTemplate_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testCESAlarmTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCESAlarmTemplateBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAlarmTemplateName, "name", "template_1"),
+					resource.TestCheckResourceAttr(resourceAlarmTemplateName, "policies.#", "1"),
+				),
+			},
+			{
+				Config: testCESAlarmTemplateUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAlarmTemplateName, "description", "ECS alarms"),
+					resource.TestCheckResourceAttr(resourceAlarmTemplateName, "policies.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceAlarmTemplateName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCESAlarmTemplateDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CesV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ces client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ces_alarm_template" {
+			continue
+		}
+
+		url := client.ServiceURL("alarm-templates", rs.Primary.ID)
+		if _, err := client.Get(url, nil, nil); err == nil {
+			return fmt.Errorf("Alarm template still exists")
+		}
+	}
+
+	return nil
+}
+
+const testCESAlarmTemplateBasic = `
+resource "opentelekomcloud_ces_alarm_template" "template_1" {
+  name = "template_1"
+
+  policies {
+    namespace           = "SYS.ECS"
+    dimension_name      = "instance_id"
+    metric_name         = "cpu_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 80
+    unit                = "%"
+    count               = 3
+  }
+}
+`
+
+const testCESAlarmTemplateUpdate = `
+resource "opentelekomcloud_ces_alarm_template" "template_1" {
+  name        = "template_1"
+  description = "ECS alarms"
+
+  policies {
+    namespace           = "SYS.ECS"
+    dimension_name      = "instance_id"
+    metric_name         = "cpu_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 90
+    unit                = "%"
+    count               = 3
+    alarm_level         = 1
+  }
+  policies {
+    namespace           = "SYS.ECS"
+    dimension_name      = "instance_id"
+    metric_name         = "mem_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">="
+    value               = 95
+    unit                = "%"
+    count               = 3
+    suppress_duration   = 3600
+  }
+}
+`
@@ -15,6 +15,7 @@ import (
 
 func TestCESAlarmRule_basic(t *testing.T) {
 	var ar alarmrule.AlarmRule
+	var ruleID string
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { common.TestAccPreCheck(t) },
@@ -25,6 +26,7 @@ func TestCESAlarmRule_basic(t *testing.T) {
 				Config: testCESAlarmRule_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testCESAlarmRuleExists("opentelekomcloud_ces_alarmrule.alarmrule_1", &ar),
+					testCESAlarmRuleID("opentelekomcloud_ces_alarmrule.alarmrule_1", &ruleID),
 				),
 			},
 			{
@@ -32,12 +34,34 @@ func TestCESAlarmRule_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_ces_alarmrule.alarmrule_1", "alarm_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "alarm_level", "3"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "condition.0.value", "10"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarmrule.alarmrule_1", "metric.0.metric_name", "network_incoming_bytes_rate_inband"),
+					testCESAlarmRuleID("opentelekomcloud_ces_alarmrule.alarmrule_1", &ruleID),
 				),
 			},
 		},
 	})
 }
 
+// testCESAlarmRuleID saves ID of the alarm rule to ensure the rule is updated in place
+func testCESAlarmRuleID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if *id != "" && *id != rs.Primary.ID {
+			return fmt.Errorf("Alarm rule was recreated")
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
 func testCESAlarmRuleDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	networkingClient, err := config.CesV1Client(env.OS_REGION_NAME)
@@ -150,17 +174,18 @@ resource "opentelekomcloud_ces_alarmrule" "alarmrule_1" {
 
   metric {
     namespace = "SYS.ECS"
-    metric_name = "network_outgoing_bytes_rate_inband"
+    metric_name = "network_incoming_bytes_rate_inband"
     dimensions {
         name = "instance_id"
         value = opentelekomcloud_compute_instance_v2.vm_1.id
     }
   }
+  alarm_level = 3
   condition  {
     period = 300
     filter = "average"
     comparison_operator = ">"
-    value = 6
+    value = 10
     unit = "B/s"
     count = 1
   }
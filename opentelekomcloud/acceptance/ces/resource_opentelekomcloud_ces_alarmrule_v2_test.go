@@ -0,0 +1,181 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceAlarmRuleV2Name = "opentelekomcloud_ces_alarmrule_v2.alarmrule_1"
+
+func TestCESAlarmRuleV2_basic(t *testing.T) {
+	var ruleID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testCESAlarmRuleV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCESAlarmRuleV2Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testCESAlarmRuleID(resourceAlarmRuleV2Name, &ruleID),
+					resource.TestCheckResourceAttr(resourceAlarmRuleV2Name, "policies.#", "2"),
+					resource.TestCheckResourceAttr(resourceAlarmRuleV2Name, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceAlarmRuleV2Name, "enabled", "true"),
+				),
+			},
+			{
+				Config: testCESAlarmRuleV2Update,
+				Check: resource.ComposeTestCheckFunc(
+					testCESAlarmRuleID(resourceAlarmRuleV2Name, &ruleID),
+					resource.TestCheckResourceAttr(resourceAlarmRuleV2Name, "policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceAlarmRuleV2Name, "policies.0.value", "90"),
+					resource.TestCheckResourceAttr(resourceAlarmRuleV2Name, "resources.#", "2"),
+					resource.TestCheckResourceAttr(resourceAlarmRuleV2Name, "name", "alarm_rule_v2_updated"),
+					resource.TestCheckResourceAttr(resourceAlarmRuleV2Name, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceAlarmRuleV2Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCESAlarmRuleV2Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.CesV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ces client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ces_alarmrule_v2" {
+			continue
+		}
+
+		var result struct {
+			Alarms []interface{} `json:"alarms"`
+		}
+		url := client.ServiceURL("alarms") + "?alarm_id=" + rs.Primary.ID
+		if _, err := client.Get(url, &result, nil); err == nil && len(result.Alarms) > 0 {
+			return fmt.Errorf("Alarm rule still exists")
+		}
+	}
+
+	return nil
+}
+
+var testCESAlarmRuleV2Instances = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm_1" {
+  name = "instance_1"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_compute_instance_v2" "vm_2" {
+  name = "instance_2"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name         = "topic_1"
+  display_name = "The display name of topic_1"
+}
+`, env.OS_NETWORK_ID, env.OS_NETWORK_ID)
+
+var testCESAlarmRuleV2Basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_ces_alarmrule_v2" "alarmrule_1" {
+  name      = "alarm_rule_v2"
+  namespace = "SYS.ECS"
+
+  resources {
+    dimensions {
+      name  = "instance_id"
+      value = opentelekomcloud_compute_instance_v2.vm_1.id
+    }
+  }
+
+  policies {
+    metric_name         = "cpu_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 80
+    unit                = "%%"
+    count               = 3
+  }
+  policies {
+    metric_name         = "network_outgoing_bytes_rate_inband"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 6
+    unit                = "B/s"
+    count               = 1
+    alarm_level         = 3
+  }
+
+  alarm_notifications {
+    type              = "notification"
+    notification_list = [opentelekomcloud_smn_topic_v2.topic_1.topic_urn]
+  }
+}
+`, testCESAlarmRuleV2Instances)
+
+var testCESAlarmRuleV2Update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_ces_alarmrule_v2" "alarmrule_1" {
+  name      = "alarm_rule_v2_updated"
+  namespace = "SYS.ECS"
+  enabled   = false
+
+  resources {
+    dimensions {
+      name  = "instance_id"
+      value = opentelekomcloud_compute_instance_v2.vm_1.id
+    }
+  }
+  resources {
+    dimensions {
+      name  = "instance_id"
+      value = opentelekomcloud_compute_instance_v2.vm_2.id
+    }
+  }
+
+  policies {
+    metric_name         = "cpu_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 90
+    unit                = "%%"
+    count               = 3
+    suppress_duration   = 3600
+  }
+
+  alarm_notifications {
+    type              = "notification"
+    notification_list = [opentelekomcloud_smn_topic_v2.topic_1.topic_urn]
+  }
+  ok_notifications {
+    type              = "notification"
+    notification_list = [opentelekomcloud_smn_topic_v2.topic_1.topic_urn]
+  }
+}
+`, testCESAlarmRuleV2Instances)
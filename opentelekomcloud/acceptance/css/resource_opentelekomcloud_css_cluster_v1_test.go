@@ -40,6 +40,14 @@ func TestAccCssClusterV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "nodes.#", "2"),
 				),
 			},
+			{
+				Config: testAccCssClusterV1_resize(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCssClusterV1Exists(),
+					resource.TestCheckResourceAttr(resourceName, "node_config.0.flavor", "css.large.8"),
+					resource.TestCheckResourceAttr(resourceName, "node_config.0.volume.0.size", "80"),
+				),
+			},
 		},
 	})
 }
@@ -191,6 +199,37 @@ resource "opentelekomcloud_css_cluster_v1" "cluster" {
 `, name, env.OS_NETWORK_ID, env.OS_VPC_ID, env.OS_AVAILABILITY_ZONE)
 }
 
+func testAccCssClusterV1_resize(name string) string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "default"
+}
+
+resource "opentelekomcloud_css_cluster_v1" "cluster" {
+  expect_node_num = 2
+  name            = "%[1]s"
+  node_config {
+    flavor = "css.large.8"
+    network_info {
+      security_group_id = data.opentelekomcloud_networking_secgroup_v2.secgroup.id
+      network_id        = "%s"
+      vpc_id            = "%s"
+    }
+    volume {
+      volume_type = "COMMON"
+      size        = 80
+    }
+
+    availability_zone = "%s"
+  }
+
+  enable_https     = true
+  enable_authority = true
+  admin_pass       = "QwertyUI!"
+}
+`, name, env.OS_NETWORK_ID, env.OS_VPC_ID, env.OS_AVAILABILITY_ZONE)
+}
+
 func testAccCheckCssClusterV1Destroy(s *terraform.State) error {
 	config := acc.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.CssV1Client(env.OS_REGION_NAME)
@@ -0,0 +1,58 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	acc "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceSnapshotConfigurationName = "opentelekomcloud_css_snapshot_configuration_v1.config"
+
+func TestAccCssSnapshotConfigurationV1_basic(t *testing.T) {
+	name := fmt.Sprintf("css-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckCssClusterV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssSnapshotConfigurationV1_basic(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSnapshotConfigurationName, "creation_policy.0.prefix", "snap"),
+					resource.TestCheckResourceAttr(resourceSnapshotConfigurationName, "creation_policy.0.keepday", "1"),
+					resource.TestCheckResourceAttrSet(resourceSnapshotConfigurationName, "configuration.0.bucket"),
+				),
+			},
+			{
+				Config: testAccCssSnapshotConfigurationV1_basic(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSnapshotConfigurationName, "creation_policy.0.keepday", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCssSnapshotConfigurationV1_basic(name string, keepDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_css_snapshot_configuration_v1" "config" {
+  cluster_id = opentelekomcloud_css_cluster_v1.cluster.id
+  automatic  = true
+
+  creation_policy {
+    prefix      = "snap"
+    period      = "00:00 GMT+03:00"
+    keepday     = %d
+    enable      = true
+    delete_auto = true
+  }
+}
+`, testAccCssClusterV1_basic(name), keepDays)
+}
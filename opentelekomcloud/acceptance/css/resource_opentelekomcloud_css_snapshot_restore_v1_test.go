@@ -0,0 +1,48 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	acc "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceSnapshotRestoreName = "opentelekomcloud_css_snapshot_restore_v1.restore"
+
+func TestAccCssSnapshotRestoreV1_basic(t *testing.T) {
+	name := fmt.Sprintf("css-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckCssClusterV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssSnapshotRestoreV1_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceSnapshotRestoreName, "snapshot_id",
+						resourceSnapshotName, "id"),
+					resource.TestCheckResourceAttrPair(resourceSnapshotRestoreName, "target_cluster_id",
+						"opentelekomcloud_css_cluster_v1.cluster", "id"),
+					resource.TestCheckResourceAttrSet(resourceSnapshotRestoreName, "restore_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCssSnapshotRestoreV1_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_css_snapshot_restore_v1" "restore" {
+  cluster_id        = opentelekomcloud_css_snapshot_v1.snapshot.cluster_id
+  snapshot_id       = opentelekomcloud_css_snapshot_v1.snapshot.id
+  target_cluster_id = opentelekomcloud_css_cluster_v1.cluster.id
+  indices           = "*"
+}
+`, testAccCssSnapshotV1_basic(name))
+}
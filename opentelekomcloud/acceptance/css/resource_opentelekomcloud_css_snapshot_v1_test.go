@@ -0,0 +1,62 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	acc "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceSnapshotName = "opentelekomcloud_css_snapshot_v1.snapshot"
+
+func TestAccCssSnapshotV1_basic(t *testing.T) {
+	name := fmt.Sprintf("css-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckCssClusterV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssSnapshotV1_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSnapshotName, "name", "snapshot-"+name),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceSnapshotName, "backup_method", "manual"),
+				),
+			},
+			{
+				ResourceName:      resourceSnapshotName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCssSnapshotV1ImportStateIdFunc(),
+			},
+		},
+	})
+}
+
+func testAccCssSnapshotV1ImportStateIdFunc() resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		snapshot, ok := s.RootModule().Resources[resourceSnapshotName]
+		if !ok {
+			return "", fmt.Errorf("snapshot not found: %s", resourceSnapshotName)
+		}
+		return fmt.Sprintf("%s/%s", snapshot.Primary.Attributes["cluster_id"], snapshot.Primary.ID), nil
+	}
+}
+
+func testAccCssSnapshotV1_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_css_snapshot_v1" "snapshot" {
+  cluster_id  = opentelekomcloud_css_snapshot_configuration_v1.config.id
+  name        = "snapshot-%s"
+  description = "terraform snapshot"
+}
+`, testAccCssSnapshotConfigurationV1_basic(name, 1), name)
+}
@@ -0,0 +1,33 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccCTSTracesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCTSTracesDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_cts_traces.traces", "id"),
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_cts_traces.traces", "traces.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCTSTracesDataSource_basic = `
+data "opentelekomcloud_cts_traces" "traces" {
+  service_type = "IAM"
+  duration     = "24h"
+  limit        = 10
+}
+`
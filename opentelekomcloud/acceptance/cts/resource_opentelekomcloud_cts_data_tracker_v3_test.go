@@ -0,0 +1,90 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceDataTrackerName = "opentelekomcloud_cts_data_tracker_v3.tracker"
+
+func TestAccCTSDataTrackerV3_basic(t *testing.T) {
+	var bucketName = fmt.Sprintf("terra-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCTSDataTrackerV3_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDataTrackerName, "name", "data-tracker"),
+					resource.TestCheckResourceAttr(resourceDataTrackerName, "data_bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceDataTrackerName, "data_operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceDataTrackerName, "status", "enabled"),
+				),
+			},
+			{
+				Config: testAccCTSDataTrackerV3_update(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDataTrackerName, "data_operation.#", "2"),
+					resource.TestCheckResourceAttr(resourceDataTrackerName, "file_prefix_name", "data"),
+					resource.TestCheckResourceAttr(resourceDataTrackerName, "status", "disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceDataTrackerName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCTSDataTrackerV3_base(bucketName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "data" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_obs_bucket" "traces" {
+  bucket        = "%[1]s-traces"
+  acl           = "private"
+  force_destroy = true
+}
+`, bucketName)
+}
+
+func testAccCTSDataTrackerV3_basic(bucketName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cts_data_tracker_v3" "tracker" {
+  name           = "data-tracker"
+  data_bucket    = opentelekomcloud_obs_bucket.data.bucket
+  data_operation = ["WRITE"]
+  bucket_name    = opentelekomcloud_obs_bucket.traces.bucket
+}
+`, testAccCTSDataTrackerV3_base(bucketName))
+}
+
+func testAccCTSDataTrackerV3_update(bucketName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_cts_data_tracker_v3" "tracker" {
+  name             = "data-tracker"
+  data_bucket      = opentelekomcloud_obs_bucket.data.bucket
+  data_operation   = ["READ", "WRITE"]
+  bucket_name      = opentelekomcloud_obs_bucket.traces.bucket
+  file_prefix_name = "data"
+  enabled          = false
+}
+`, testAccCTSDataTrackerV3_base(bucketName))
+}
@@ -0,0 +1,78 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceEventNotificationName = "opentelekomcloud_cts_event_notification_v3.notification"
+
+func TestAccCTSEventNotificationV3_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCTSEventNotificationV3_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEventNotificationName, "name", "test_notification"),
+					resource.TestCheckResourceAttr(resourceEventNotificationName, "operation_type", "customized"),
+					resource.TestCheckResourceAttr(resourceEventNotificationName, "operations.#", "1"),
+					resource.TestCheckResourceAttr(resourceEventNotificationName, "status", "enabled"),
+				),
+			},
+			{
+				Config: testAccCTSEventNotificationV3_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceEventNotificationName, "name", "test_notification_updated"),
+					resource.TestCheckResourceAttr(resourceEventNotificationName, "operation_type", "complete"),
+					resource.TestCheckResourceAttr(resourceEventNotificationName, "status", "disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceEventNotificationName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccCTSEventNotificationV3_basic = `
+resource "opentelekomcloud_smn_topic_v2" "topic" {
+  name = "topic_cts_notification"
+}
+
+resource "opentelekomcloud_cts_event_notification_v3" "notification" {
+  name           = "test_notification"
+  operation_type = "customized"
+  topic_id       = opentelekomcloud_smn_topic_v2.topic.id
+
+  operations {
+    service_type  = "ECS"
+    resource_type = "ecs"
+    trace_names   = ["createServer", "deleteServer"]
+  }
+
+  notify_user_list {
+    user_group = "admin"
+    user_list  = ["user1"]
+  }
+}
+`
+
+const testAccCTSEventNotificationV3_update = `
+resource "opentelekomcloud_smn_topic_v2" "topic" {
+  name = "topic_cts_notification"
+}
+
+resource "opentelekomcloud_cts_event_notification_v3" "notification" {
+  name           = "test_notification_updated"
+  operation_type = "complete"
+  topic_id       = opentelekomcloud_smn_topic_v2.topic.id
+  enabled        = false
+}
+`
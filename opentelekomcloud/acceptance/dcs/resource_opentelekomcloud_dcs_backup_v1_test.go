@@ -0,0 +1,61 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceBackupName = "opentelekomcloud_dcs_backup_v1.backup_1"
+
+func TestAccDcsBackupV1_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDcs(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsV1Backup_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceBackupName, "description", "terraform backup"),
+					resource.TestCheckResourceAttr(resourceBackupName, "status", "succeed"),
+					resource.TestCheckResourceAttrSet(resourceBackupName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceBackupName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDcsV1BackupImportStateIdFunc(),
+			},
+		},
+	})
+}
+
+func testAccDcsV1BackupImportStateIdFunc() resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		backup, ok := s.RootModule().Resources[resourceBackupName]
+		if !ok {
+			return "", fmt.Errorf("backup not found: %s", resourceBackupName)
+		}
+		return fmt.Sprintf("%s/%s", backup.Primary.Attributes["instance_id"], backup.Primary.ID), nil
+	}
+}
+
+func testAccDcsV1Backup_basic(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dcs_backup_v1" "backup_1" {
+  instance_id = opentelekomcloud_dcs_instance_v1.instance_1.id
+  description = "terraform backup"
+}
+`, testAccDcsV1WhitelistInstance(instanceName))
+}
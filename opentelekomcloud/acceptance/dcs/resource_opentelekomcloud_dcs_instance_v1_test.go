@@ -63,6 +63,8 @@ func TestAccDcsInstancesV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "backup_policy.0.begin_at", "01:00-02:00"),
 					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "backup_policy.0.save_days", "2"),
 					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "backup_policy.0.backup_at.#", "3"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "parameters.maxmemory-policy", "allkeys-lru"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dcs_instance_v1.instance_1", "parameters.timeout", "100"),
 				),
 			},
 			{
@@ -232,6 +234,10 @@ resource "opentelekomcloud_dcs_instance_v1" "instance_1" {
     backup_at = [1, 2, 4]
     save_days = 2
   }
+  parameters = {
+    "maxmemory-policy" = "allkeys-lru"
+    "timeout"          = "100"
+  }
   depends_on = [
     "data.opentelekomcloud_dcs_product_v1.product_1",
     "opentelekomcloud_networking_secgroup_v2.secgroup_1"]
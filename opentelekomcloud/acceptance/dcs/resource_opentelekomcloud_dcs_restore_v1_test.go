@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceRestoreName = "opentelekomcloud_dcs_restore_v1.restore_1"
+
+func TestAccDcsRestoreV1_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDcs(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsV1Restore_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRestoreName, "status", "succeed"),
+					resource.TestCheckResourceAttrPair(resourceRestoreName, "backup_id", resourceBackupName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDcsV1Restore_basic(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dcs_restore_v1" "restore_1" {
+  instance_id = opentelekomcloud_dcs_instance_v1.instance_1.id
+  backup_id   = opentelekomcloud_dcs_backup_v1.backup_1.id
+  description = "terraform restore"
+}
+`, testAccDcsV1Backup_basic(instanceName))
+}
@@ -0,0 +1,156 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/whitelists"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceWhitelistName = "opentelekomcloud_dcs_whitelist_v1.whitelist_1"
+
+func TestAccDcsWhitelistV1_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDcs(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDcsV1WhitelistDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsV1Whitelist_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV1WhitelistExists(resourceWhitelistName),
+					resource.TestCheckResourceAttr(resourceWhitelistName, "enable_whitelist", "true"),
+					resource.TestCheckResourceAttr(resourceWhitelistName, "whitelist.#", "1"),
+					resource.TestCheckResourceAttr(resourceWhitelistName, "whitelist.0.group_name", "test-group-1"),
+					resource.TestCheckResourceAttr(resourceWhitelistName, "whitelist.0.ip_list.#", "2"),
+				),
+			},
+			{
+				Config: testAccDcsV1Whitelist_update(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV1WhitelistExists(resourceWhitelistName),
+					resource.TestCheckResourceAttr(resourceWhitelistName, "whitelist.#", "2"),
+					resource.TestCheckResourceAttr(resourceWhitelistName, "whitelist.1.group_name", "test-group-2"),
+				),
+			},
+			{
+				ResourceName:      resourceWhitelistName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcsV1WhitelistDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DcsV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating DCSv1 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dcs_whitelist_v1" {
+			continue
+		}
+
+		whitelist, err := whitelists.Get(client, rs.Primary.ID).Extract()
+		if err == nil && whitelist.Enable {
+			return fmt.Errorf("DCS instance whitelist is still enabled")
+		}
+	}
+	return nil
+}
+
+func testAccCheckDcsV1WhitelistExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.DcsV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating DCSv1 client: %w", err)
+		}
+
+		whitelist, err := whitelists.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("error getting DCS instance whitelist: %w", err)
+		}
+		if !whitelist.Enable {
+			return fmt.Errorf("DCS instance whitelist is not enabled")
+		}
+		return nil
+	}
+}
+
+func testAccDcsV1WhitelistInstance(instanceName string) string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_dcs_az_v1" "az_1" {
+  port = "8002"
+  code = "%s"
+}
+data "opentelekomcloud_dcs_product_v1" "product_1" {
+  spec_code = "redis.ha.xu1.large.r2.2"
+}
+resource "opentelekomcloud_dcs_instance_v1" "instance_1" {
+  name            = "%s"
+  engine_version  = "5.0"
+  password        = "Hungarian_rapsody"
+  engine          = "Redis"
+  capacity        = 2
+  vpc_id          = "%s"
+  subnet_id       = "%s"
+  available_zones = [data.opentelekomcloud_dcs_az_v1.az_1.id]
+  product_id      = data.opentelekomcloud_dcs_product_v1.product_1.id
+}
+`, env.OS_AVAILABILITY_ZONE, instanceName, env.OS_VPC_ID, env.OS_NETWORK_ID)
+}
+
+func testAccDcsV1Whitelist_basic(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dcs_whitelist_v1" "whitelist_1" {
+  instance_id = opentelekomcloud_dcs_instance_v1.instance_1.id
+
+  whitelist {
+    group_name = "test-group-1"
+    ip_list    = ["10.10.10.1", "10.10.10.2"]
+  }
+}
+`, testAccDcsV1WhitelistInstance(instanceName))
+}
+
+func testAccDcsV1Whitelist_update(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dcs_whitelist_v1" "whitelist_1" {
+  instance_id = opentelekomcloud_dcs_instance_v1.instance_1.id
+
+  whitelist {
+    group_name = "test-group-1"
+    ip_list    = ["10.10.10.1", "10.10.10.2"]
+  }
+  whitelist {
+    group_name = "test-group-2"
+    ip_list    = ["10.10.20.0/24"]
+  }
+}
+`, testAccDcsV1WhitelistInstance(instanceName))
+}
@@ -0,0 +1,47 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceBackupName = "opentelekomcloud_dds_backup_v3.backup"
+
+func TestAccDDSV3Backup_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDDSV3InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDDSBackupV3Config_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceBackupName, "name", "dds-backup"),
+					resource.TestCheckResourceAttr(resourceBackupName, "type", "Manual"),
+					resource.TestCheckResourceAttr(resourceBackupName, "status", "COMPLETED"),
+					resource.TestCheckResourceAttrPair(resourceBackupName, "instance_id",
+						"opentelekomcloud_dds_instance_v3.instance", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceBackupName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var testAccDDSBackupV3Config_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dds_backup_v3" "backup" {
+  instance_id = opentelekomcloud_dds_instance_v3.instance.id
+  name        = "dds-backup"
+  description = "manual backup"
+}
+`, TestAccDDSInstanceV3Config_basic)
@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -28,6 +29,42 @@ func TestAccDDSV3Instance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.instance", "ssl", "true"),
 				),
 			},
+			{
+				Config: TestAccDDSInstanceV3Config_updated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDDSV3InstanceExists("opentelekomcloud_dds_instance_v3.instance"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.instance", "flavor.0.size", "30"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.instance", "flavor.0.spec_code", "dds.mongodb.s2.large.4.repset"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.instance", "backup_strategy.0.start_time", "10:00-11:00"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.instance", "backup_strategy.0.keep_days", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDDSV3Instance_scaleSharding(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDDSV3InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDDSInstanceV3ConfigSharding(2, 2, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDDSV3InstanceExists("opentelekomcloud_dds_instance_v3.instance"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.instance", "mode", "Sharding"),
+				),
+			},
+			{
+				Config: testAccDDSInstanceV3ConfigSharding(3, 3, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDDSV3InstanceExists("opentelekomcloud_dds_instance_v3.instance"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.instance", "flavor.0.num", "3"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.instance", "flavor.1.num", "3"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.instance", "flavor.1.size", "30"),
+				),
+			},
 		},
 	})
 }
@@ -48,6 +85,29 @@ func TestAccDDSV3Instance_minConfig(t *testing.T) {
 	})
 }
 
+func TestAccDDSV3Instance_restoreFromBackup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDDSV3InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDDSInstanceV3Config_restore,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDDSV3InstanceExists("opentelekomcloud_dds_instance_v3.restored"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.restored", "status", "normal"),
+				),
+			},
+			{
+				Config: testAccDDSInstanceV3Config_restoreDefaultTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_dds_instance_v3.restored", "configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDDSV3InstanceDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.DdsV3Client(env.OS_REGION_NAME)
@@ -170,3 +230,124 @@ resource "opentelekomcloud_dds_instance_v3" "instance" {
     spec_code = "dds.mongodb.s2.medium.4.repset"
   }
 }`, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
+
+var TestAccDDSInstanceV3Config_updated = fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg_acc" {
+  name = "secgroup_acc"
+}
+resource "opentelekomcloud_dds_instance_v3" "instance" {
+  name              = "dds-instance"
+  availability_zone = "%s"
+  region            = "%s"
+  datastore {
+    type           = "DDS-Community"
+    version        = "3.4"
+    storage_engine = "wiredTiger"
+  }
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg_acc.id
+  password          = "5ecuredPa55w0rd@"
+  mode              = "ReplicaSet"
+  flavor {
+    type = "replica"
+    num = 1
+    storage = "ULTRAHIGH"
+    size = 30
+    spec_code = "dds.mongodb.s2.large.4.repset"
+  }
+  backup_strategy {
+    start_time = "10:00-11:00"
+    keep_days = "2"
+  }
+}`, env.OS_AVAILABILITY_ZONE, env.OS_REGION_NAME, env.OS_VPC_ID, env.OS_NETWORK_ID)
+
+func testAccDDSInstanceV3ConfigSharding(mongosNum, shardNum, shardSize int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg_acc" {
+  name = "secgroup_acc"
+}
+resource "opentelekomcloud_dds_instance_v3" "instance" {
+  name              = "dds-instance"
+  availability_zone = "%s"
+  datastore {
+    type           = "DDS-Community"
+    version        = "3.4"
+    storage_engine = "wiredTiger"
+  }
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg_acc.id
+  password          = "5ecuredPa55w0rd@"
+  mode              = "Sharding"
+  flavor {
+    type      = "mongos"
+    num       = %d
+    spec_code = "dds.mongodb.s2.medium.4.mongos"
+  }
+  flavor {
+    type      = "shard"
+    num       = %d
+    storage   = "ULTRAHIGH"
+    size      = %d
+    spec_code = "dds.mongodb.s2.medium.4.shard"
+  }
+  flavor {
+    type      = "config"
+    num       = 1
+    storage   = "ULTRAHIGH"
+    size      = 20
+    spec_code = "dds.mongodb.s2.large.2.config"
+  }
+}`, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID, mongosNum, shardNum, shardSize)
+}
+
+var testAccDDSInstanceV3Config_restore = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dds_parameter_template_v3" "template" {
+  name = "dds-template-replica"
+  values = {
+    connPoolMaxConnsPerHost = "800"
+  }
+  datastore {
+    version   = "3.4"
+    node_type = "replica"
+  }
+}
+
+resource "opentelekomcloud_dds_instance_v3" "restored" {
+  name              = "dds-instance-restored"
+  availability_zone = "%s"
+  datastore {
+    type           = "DDS-Community"
+    version        = "3.4"
+    storage_engine = "wiredTiger"
+  }
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg_acc.id
+  password          = "5ecuredPa55w0rd@"
+  mode              = "ReplicaSet"
+  flavor {
+    type      = "replica"
+    num       = 1
+    size      = 20
+    spec_code = "dds.mongodb.s2.medium.4.repset"
+  }
+  configuration {
+    type = "replica"
+    id   = opentelekomcloud_dds_parameter_template_v3.template.id
+  }
+  restore_from {
+    instance_id = opentelekomcloud_dds_instance_v3.instance.id
+    backup_id   = opentelekomcloud_dds_backup_v3.backup.id
+  }
+}
+`, testAccDDSBackupV3Config_basic, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
+
+var testAccDDSInstanceV3Config_restoreDefaultTemplate = strings.Replace(testAccDDSInstanceV3Config_restore, `  configuration {
+    type = "replica"
+    id   = opentelekomcloud_dds_parameter_template_v3.template.id
+  }
+`, "", 1)
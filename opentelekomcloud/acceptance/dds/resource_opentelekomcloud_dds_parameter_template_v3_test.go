@@ -0,0 +1,120 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceParameterTemplateName = "opentelekomcloud_dds_parameter_template_v3.template"
+
+func TestAccDDSV3ParameterTemplate_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDDSV3ParameterTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDDSParameterTemplateV3Config_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceParameterTemplateName, "name", "dds-template"),
+					resource.TestCheckResourceAttr(resourceParameterTemplateName, "values.connPoolMaxConnsPerHost", "800"),
+					resource.TestCheckResourceAttrSet(resourceParameterTemplateName, "parameters.#"),
+				),
+			},
+			{
+				Config: testAccDDSParameterTemplateV3Config_updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceParameterTemplateName, "name", "dds-template-updated"),
+					resource.TestCheckResourceAttr(resourceParameterTemplateName, "values.connPoolMaxConnsPerHost", "500"),
+				),
+			},
+			{
+				Config: testAccDDSParameterTemplateV3Config_defaults,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceParameterTemplateName, "values.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceParameterTemplateName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDDSV3ParameterTemplateDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DdsV3Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dds_parameter_template_v3" {
+			continue
+		}
+
+		_, err := client.Get(client.ServiceURL("configurations", rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("DDS parameter template still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const testAccDDSParameterTemplateV3Config_basic = `
+resource "opentelekomcloud_dds_parameter_template_v3" "template" {
+  name        = "dds-template"
+  description = "test template"
+
+  values = {
+    connPoolMaxConnsPerHost = "800"
+  }
+
+  datastore {
+    version   = "3.4"
+    node_type = "mongos"
+  }
+}
+`
+
+const testAccDDSParameterTemplateV3Config_updated = `
+resource "opentelekomcloud_dds_parameter_template_v3" "template" {
+  name        = "dds-template-updated"
+  description = "test template"
+
+  values = {
+    connPoolMaxConnsPerHost = "500"
+  }
+
+  datastore {
+    version   = "3.4"
+    node_type = "mongos"
+  }
+}
+`
+
+const testAccDDSParameterTemplateV3Config_defaults = `
+resource "opentelekomcloud_dds_parameter_template_v3" "template" {
+  name        = "dds-template-updated"
+  description = "test template"
+
+  datastore {
+    version   = "3.4"
+    node_type = "mongos"
+  }
+}
+`
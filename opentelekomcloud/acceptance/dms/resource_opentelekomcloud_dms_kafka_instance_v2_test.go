@@ -0,0 +1,130 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceKafkaInstanceName = "opentelekomcloud_dms_kafka_instance_v2.instance_1"
+
+func TestAccDmsKafkaInstanceV2_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dms_kafka_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDms(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDmsKafkaV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaV2Instance_basic(instanceName, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsKafkaV2InstanceExists(resourceKafkaInstanceName),
+					resource.TestCheckResourceAttr(resourceKafkaInstanceName, "name", instanceName),
+					resource.TestCheckResourceAttr(resourceKafkaInstanceName, "storage_space", "600"),
+					resource.TestCheckResourceAttr(resourceKafkaInstanceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceKafkaInstanceName, "connect_address"),
+				),
+			},
+			{
+				Config: testAccDmsKafkaV2Instance_basic(instanceName+"_updated", 900),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsKafkaV2InstanceExists(resourceKafkaInstanceName),
+					resource.TestCheckResourceAttr(resourceKafkaInstanceName, "name", instanceName+"_updated"),
+					resource.TestCheckResourceAttr(resourceKafkaInstanceName, "storage_space", "900"),
+				),
+			},
+			{
+				ResourceName:      resourceKafkaInstanceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"manager_password",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckDmsKafkaV2InstanceDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.DmsV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dms_kafka_instance_v2" {
+			continue
+		}
+
+		_, err := client.Get(client.ServiceURL("instances", rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("DMS Kafka instance still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckDmsKafkaV2InstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.DmsV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+		}
+
+		_, err = client.Get(client.ServiceURL("instances", rs.Primary.ID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("error getting DMS Kafka instance %s: %w", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccDmsKafkaV2Instance_basic(instanceName string, storageSpace int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_kafka"
+  description = "secgroup for kafka"
+}
+
+data "opentelekomcloud_dms_az_v1" "az_1" {}
+
+data "opentelekomcloud_dms_product_v1" "product_1" {
+  engine        = "kafka"
+  instance_type = "cluster"
+  version       = "2.3.0"
+}
+
+resource "opentelekomcloud_dms_kafka_instance_v2" "instance_1" {
+  name              = "%s"
+  product_id        = data.opentelekomcloud_dms_product_v1.product_1.id
+  storage_space     = %d
+  storage_spec_code = "dms.physical.storage.ultra"
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+  available_zones   = [data.opentelekomcloud_dms_az_v1.az_1.id]
+  manager_user      = "kafka-manager"
+  manager_password  = "Kafka_Test@123"
+  access_user       = "kafka-user"
+  password          = "Kafka_Test@123"
+}
+`, instanceName, storageSpace, env.OS_VPC_ID, env.OS_NETWORK_ID)
+}
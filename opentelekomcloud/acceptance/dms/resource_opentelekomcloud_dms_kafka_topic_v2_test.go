@@ -0,0 +1,57 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceKafkaTopicName = "opentelekomcloud_dms_kafka_topic_v2.topic_1"
+
+func TestAccDmsKafkaTopicV2_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dms_kafka_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDms(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDmsKafkaV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaV2Topic_basic(instanceName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceKafkaTopicName, "name", "topic-1"),
+					resource.TestCheckResourceAttr(resourceKafkaTopicName, "partitions", "3"),
+					resource.TestCheckResourceAttr(resourceKafkaTopicName, "replicas", "3"),
+				),
+			},
+			{
+				Config: testAccDmsKafkaV2Topic_basic(instanceName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceKafkaTopicName, "partitions", "6"),
+				),
+			},
+			{
+				ResourceName:      resourceKafkaTopicName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaV2Topic_basic(instanceName string, partitions int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dms_kafka_topic_v2" "topic_1" {
+  instance_id    = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name           = "topic-1"
+  partitions     = %d
+  retention_time = 24
+}
+`, testAccDmsKafkaV2Instance_basic(instanceName, 600), partitions)
+}
@@ -0,0 +1,63 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceKafkaUserName = "opentelekomcloud_dms_kafka_user_v2.user_1"
+
+func TestAccDmsKafkaUserV2_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dms_kafka_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDms(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDmsKafkaV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaV2User_basic(instanceName, "pub"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceKafkaUserName, "name", "app-user"),
+					resource.TestCheckResourceAttr(resourceKafkaUserName, "topic_access.#", "1"),
+				),
+			},
+			{
+				Config: testAccDmsKafkaV2User_basic(instanceName, "all"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceKafkaUserName, "topic_access.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceKafkaUserName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaV2User_basic(instanceName, accessPolicy string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dms_kafka_user_v2" "user_1" {
+  instance_id = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name        = "app-user"
+  password    = "Kafka_User@123"
+
+  topic_access {
+    topic         = opentelekomcloud_dms_kafka_topic_v2.topic_1.name
+    access_policy = "%s"
+  }
+}
+`, testAccDmsKafkaV2Topic_basic(instanceName, 3), accessPolicy)
+}
@@ -0,0 +1,46 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataZoneFileName = "data.opentelekomcloud_dns_zone_file_v2.file"
+
+func TestAccDNSV2ZoneFileDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ZoneFileDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataZoneFileName, "origin", "example.com."),
+					resource.TestCheckResourceAttr(dataZoneFileName, "recordsets.#", "2"),
+					resource.TestCheckResourceAttr(dataZoneFileName, "recordsets.0.name", "mail.example.com."),
+					resource.TestCheckResourceAttr(dataZoneFileName, "recordsets.0.records.0", "10 www.example.com."),
+					resource.TestCheckResourceAttr(dataZoneFileName, "recordsets.1.name", "www.example.com."),
+					resource.TestCheckResourceAttr(dataZoneFileName, "recordsets.1.ttl", "3600"),
+					resource.TestCheckResourceAttr(dataZoneFileName, "recordsets.1.records.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDNSV2ZoneFileDataSource_basic = `
+data "opentelekomcloud_dns_zone_file_v2" "file" {
+  origin    = "example.com"
+  zone_file = <<EOT
+$TTL 1h
+@     IN  SOA  ns1 hostmaster 2021040801 7200 3600 1209600 300
+      IN  NS   ns1
+www   IN  A    192.0.2.1
+      IN  A    192.0.2.2
+mail  IN  MX   10 www ; mail exchanger
+EOT
+}
+`
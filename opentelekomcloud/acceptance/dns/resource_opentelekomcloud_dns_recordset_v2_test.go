@@ -64,6 +64,69 @@ func TestAccDNSV2RecordSet_basic(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2RecordSet_records(t *testing.T) {
+	var recordset recordsets.RecordSet
+	var updated recordsets.RecordSet
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSet_records(zoneName, `["10.1.0.1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists("opentelekomcloud_dns_recordset_v2.recordset_1", &recordset),
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.recordset_1", "records.#", "1"),
+				),
+			},
+			{
+				Config: testAccDNSV2RecordSet_records(zoneName, `["10.1.0.2", "10.1.0.3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists("opentelekomcloud_dns_recordset_v2.recordset_1", &updated),
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.recordset_1", "records.#", "2"),
+					testAccCheckDNSV2RecordSetNotRecreated(&recordset, &updated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDNSV2RecordSet_weighted(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSet_weighted(zoneName, 100, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.blue", "weight", "100"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.blue", "line", "default_view"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.blue", "shared", "false"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.green", "weight", "0"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.green", "shared", "false"),
+				),
+			},
+			{
+				Config: testAccDNSV2RecordSet_weighted(zoneName, 0, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.blue", "weight", "0"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.green", "weight", "100"),
+				),
+			},
+			{
+				ResourceName:      "opentelekomcloud_dns_recordset_v2.green",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDNSV2RecordSet_undotted(t *testing.T) {
 	zoneName := randomZoneName()
 	zoneName = strings.TrimSuffix(zoneName, ".")
@@ -168,6 +231,15 @@ func TestAccDNSV2RecordSet_shared(t *testing.T) {
 	})
 }
 
+func testAccCheckDNSV2RecordSetNotRecreated(before, after *recordsets.RecordSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			return fmt.Errorf("Record set was recreated: %s -> %s", before.ID, after.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckDNSV2RecordSetDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	dnsClient, err := config.DnsV2Client(env.OS_REGION_NAME)
@@ -281,6 +353,57 @@ resource "opentelekomcloud_dns_recordset_v2" "recordset_1" {
 `, zoneName, zoneName)
 }
 
+func testAccDNSV2RecordSet_records(zoneName string, records string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name        = "%[1]s"
+  email       = "email2@example.com"
+  description = "a zone"
+  ttl         = 6000
+}
+
+resource "opentelekomcloud_dns_recordset_v2" "recordset_1" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+  name    = "%[1]s"
+  type    = "A"
+  ttl     = 3000
+  records = %[2]s
+}
+`, zoneName, records)
+}
+
+func testAccDNSV2RecordSet_weighted(zoneName string, blueWeight, greenWeight int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name        = "%[1]s"
+  email       = "email2@example.com"
+  description = "a zone"
+  ttl         = 6000
+  type        = "public"
+}
+
+resource "opentelekomcloud_dns_recordset_v2" "blue" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+  name    = "app.%[1]s"
+  type    = "A"
+  ttl     = 300
+  records = ["10.1.0.10"]
+  line    = "default_view"
+  weight  = %[2]d
+}
+
+resource "opentelekomcloud_dns_recordset_v2" "green" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+  name    = "app.%[1]s"
+  type    = "A"
+  ttl     = 300
+  records = ["10.1.0.20"]
+  line    = "default_view"
+  weight  = %[3]d
+}
+`, zoneName, blueWeight, greenWeight)
+}
+
 func testAccDNSV2RecordSet_readTTL(zoneName string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_dns_zone_v2" "zone_1" {
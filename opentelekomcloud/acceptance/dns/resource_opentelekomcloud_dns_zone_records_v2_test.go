@@ -0,0 +1,209 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceZoneRecordsName = "opentelekomcloud_dns_zone_records_v2.records"
+
+func TestAccDNSV2ZoneRecords_zoneFile(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckDNSV2ZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ZoneRecords_zoneFile(zoneName, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "recordset.#", "3"),
+					resource.TestCheckResourceAttrSet(resourceZoneRecordsName, fmt.Sprintf("recordset_ids.www.%s/A", zoneName)),
+					testAccCheckDNSV2ZoneRecordsCount(resourceZoneRecordsName, 3),
+				),
+			},
+			{
+				Config: testAccDNSV2ZoneRecords_zoneFile(zoneName, "192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "recordset.#", "3"),
+					testAccCheckDNSV2ZoneRecordsCount(resourceZoneRecordsName, 3),
+				),
+			},
+			{
+				Config: testAccDNSV2ZoneRecords_recordset(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "recordset.#", "1"),
+					testAccCheckDNSV2ZoneRecordsCount(resourceZoneRecordsName, 1),
+				),
+			},
+			{
+				Config: testAccDNSV2ZoneRecords_weighted(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "recordset.#", "1"),
+					resource.TestCheckResourceAttr("opentelekomcloud_dns_recordset_v2.weighted", "weight", "10"),
+					testAccCheckDNSV2ZoneRecordsCount(resourceZoneRecordsName, 2),
+				),
+			},
+			{
+				ResourceName:      resourceZoneRecordsName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2ZoneRecordsDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	dnsClient, err := config.DnsV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dns_zone_records_v2" {
+			continue
+		}
+
+		allPages, err := recordsets.ListByZone(dnsClient, rs.Primary.ID, nil).AllPages()
+		if err != nil {
+			continue // zone is already deleted
+		}
+		sets, err := recordsets.ExtractRecordSets(allPages)
+		if err != nil {
+			return err
+		}
+		for _, set := range sets {
+			if set.Type != "SOA" && set.Type != "NS" {
+				return fmt.Errorf("record set %s still exists", set.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// testAccCheckDNSV2ZoneRecordsCount checks number of record sets in the zone except SOA and NS
+func testAccCheckDNSV2ZoneRecordsCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		dnsClient, err := config.DnsV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+		}
+
+		allPages, err := recordsets.ListByZone(dnsClient, rs.Primary.ID, nil).AllPages()
+		if err != nil {
+			return err
+		}
+		sets, err := recordsets.ExtractRecordSets(allPages)
+		if err != nil {
+			return err
+		}
+
+		count := 0
+		for _, set := range sets {
+			if set.Type != "SOA" && set.Type != "NS" {
+				count++
+			}
+		}
+		if count != expected {
+			return fmt.Errorf("expected %d record sets in the zone, got %d", expected, count)
+		}
+		return nil
+	}
+}
+
+func testAccDNSV2ZoneRecords_zoneFile(zoneName, address string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%s"
+  email = "email@example.com"
+  ttl   = 3000
+}
+
+resource "opentelekomcloud_dns_zone_records_v2" "records" {
+  zone_id   = opentelekomcloud_dns_zone_v2.zone_1.id
+  zone_file = <<EOT
+$TTL 1h
+@     IN  SOA  ns1.example.com. hostmaster.example.com. (
+             2021040801 7200 3600 1209600 300 )
+      IN  NS   ns1.example.com.
+www   300 IN  A    %s
+      IN  A    192.0.2.2
+mail  IN  MX   10 www
+txt   IN  TXT  "v=spf1 -all"
+EOT
+}
+`, zoneName, address)
+}
+
+func testAccDNSV2ZoneRecords_recordset(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email@example.com"
+  ttl   = 3000
+}
+
+resource "opentelekomcloud_dns_zone_records_v2" "records" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+
+  recordset {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 600
+    records = ["192.0.2.1"]
+  }
+}
+`, zoneName)
+}
+
+func testAccDNSV2ZoneRecords_weighted(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name  = "%[1]s"
+  email = "email@example.com"
+  ttl   = 3000
+}
+
+resource "opentelekomcloud_dns_recordset_v2" "weighted" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+  name    = "app.%[1]s"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.20"]
+  line    = "default_view"
+  weight  = 10
+}
+
+resource "opentelekomcloud_dns_zone_records_v2" "records" {
+  zone_id = opentelekomcloud_dns_zone_v2.zone_1.id
+
+  recordset {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 900
+    records = ["192.0.2.1"]
+  }
+
+  # weighted record set has to be kept by the update
+  depends_on = [opentelekomcloud_dns_recordset_v2.weighted]
+}
+`, zoneName)
+}
@@ -0,0 +1,70 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccKmsKeysV1DataSource_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dataSourceName := "data.opentelekomcloud_kms_keys_v1.keys"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKeysV1DataSource_keys(rName),
+			},
+			{
+				Config: testAccKmsKeysV1DataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0.default_key_flag", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "keys.0.creation_date"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_kms_keys_v1.disabled", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opentelekomcloud_kms_keys_v1.disabled", "ids.0",
+						"opentelekomcloud_kms_key_v1.disabled", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsKeysV1DataSource_keys(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "enabled" {
+  key_alias    = "tf-acc-keys-%[1]s-enabled"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_kms_key_v1" "disabled" {
+  key_alias    = "tf-acc-keys-%[1]s-disabled"
+  pending_days = "7"
+  is_enabled   = false
+}
+`, rName)
+}
+
+func testAccKmsKeysV1DataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_kms_keys_v1" "keys" {
+  alias_prefix     = "tf-acc-keys-%[2]s"
+  origin           = "kms"
+  default_key_flag = "0"
+}
+
+data "opentelekomcloud_kms_keys_v1" "disabled" {
+  alias_prefix = "tf-acc-keys-%[2]s"
+  key_state    = "3"
+}
+`, testAccKmsKeysV1DataSource_keys(rName), rName)
+}
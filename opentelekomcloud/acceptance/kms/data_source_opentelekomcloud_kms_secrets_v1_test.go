@@ -0,0 +1,70 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccKmsSecretsV1DataSource_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsSecretsV1DataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.opentelekomcloud_kms_ciphertext_v1.password", "ciphertext"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_kms_secrets_v1.secrets", "plaintext.%", "2"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_kms_secrets_v1.secrets", "plaintext.password", "Secret-Password-1"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_kms_secrets_v1.secrets", "plaintext.token", "token-without-context"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsSecretsV1DataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key" {
+  key_alias    = "tf-acc-test-kms-key-%s"
+  pending_days = "7"
+}
+
+data "opentelekomcloud_kms_ciphertext_v1" "password" {
+  key_id    = opentelekomcloud_kms_key_v1.key.id
+  plaintext = "Secret-Password-1"
+
+  encryption_context = {
+    service = "rds"
+  }
+}
+
+data "opentelekomcloud_kms_ciphertext_v1" "token" {
+  key_id    = opentelekomcloud_kms_key_v1.key.id
+  plaintext = "token-without-context"
+}
+
+data "opentelekomcloud_kms_secrets_v1" "secrets" {
+  secret {
+    name       = "password"
+    ciphertext = data.opentelekomcloud_kms_ciphertext_v1.password.ciphertext
+
+    encryption_context = {
+      service = "rds"
+    }
+  }
+
+  secret {
+    name       = "token"
+    ciphertext = data.opentelekomcloud_kms_ciphertext_v1.token.ciphertext
+  }
+}
+`, rName)
+}
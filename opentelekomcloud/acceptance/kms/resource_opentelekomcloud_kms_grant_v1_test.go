@@ -0,0 +1,72 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccKmsGrantV1_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := "opentelekomcloud_kms_grant_v1.grant"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsGrantV1_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", "opentelekomcloud_kms_key_v1.key", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "grantee_principal", "opentelekomcloud_identity_user_v3.user", "id"),
+					resource.TestCheckResourceAttr(resourceName, "grantee_principal_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "operations.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccKmsGrantV1ImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccKmsGrantV1ImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["key_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccKmsGrantV1_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key" {
+  key_alias    = "tf-acc-test-kms-key-%[1]s"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_identity_user_v3" "user" {
+  name     = "tf-acc-test-user-%[1]s"
+  password = "Password@123!"
+}
+
+resource "opentelekomcloud_kms_grant_v1" "grant" {
+  key_id            = opentelekomcloud_kms_key_v1.key.id
+  grantee_principal = opentelekomcloud_identity_user_v3.user.id
+  operations        = ["encrypt-datakey", "decrypt-datakey"]
+  name              = "grant-%[1]s"
+}
+`, rName)
+}
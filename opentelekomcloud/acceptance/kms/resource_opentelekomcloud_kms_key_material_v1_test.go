@@ -0,0 +1,57 @@
+package acceptance
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/kms"
+)
+
+func TestAccKmsKeyMaterialV1_basic(t *testing.T) {
+	material := make([]byte, 32)
+	if _, err := rand.Read(material); err != nil {
+		t.Fatal(err)
+	}
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	expiration := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+	resourceName := "opentelekomcloud_kms_key_material_v1.material"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKeyMaterialV1_basic(rName, base64.StdEncoding.EncodeToString(material), expiration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", "opentelekomcloud_kms_key_v1.key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.EnabledState),
+					resource.TestCheckResourceAttr("opentelekomcloud_kms_key_v1.key", "origin", "external"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsKeyMaterialV1_basic(rName, material, expiration string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key" {
+  key_alias    = "tf-acc-test-kms-key-%s"
+  origin       = "external"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_kms_key_material_v1" "material" {
+  key_id          = opentelekomcloud_kms_key_v1.key.id
+  key_material    = "%s"
+  expiration_time = "%s"
+}
+`, rName, material, expiration)
+}
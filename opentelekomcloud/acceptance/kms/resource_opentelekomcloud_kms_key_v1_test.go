@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -189,3 +190,87 @@ resource "opentelekomcloud_kms_key_v1" "bar" {
   is_enabled      = false
 }`, prefix)
 }
+
+func TestAccKmsKey_rotation(t *testing.T) {
+	var key keys.Key
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := "opentelekomcloud_kms_key_v1.bar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKey_rotation(rName, true, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_interval", "30"),
+				),
+			},
+			{
+				Config: testAccKmsKey_rotation(rName, true, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_interval", "90"),
+				),
+			},
+			{
+				Config: testAccKmsKey_rotation(rName, false, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccKmsKey_rotation(rName, false, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_interval", "60"),
+				),
+			},
+			{
+				Config: testAccKmsKey_rotation(rName, true, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_interval", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKmsKey_rotationExternal(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKmsKey_rotationExternal(rName),
+				ExpectError: regexp.MustCompile("rotation can't be enabled"),
+			},
+		},
+	})
+}
+
+func testAccKmsKey_rotation(prefix string, enabled bool, interval int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "bar" {
+  key_alias         = "tf-acc-test-kms-key-%s"
+  pending_days      = "7"
+  rotation_enabled  = %t
+  rotation_interval = %d
+}`, prefix, enabled, interval)
+}
+
+func testAccKmsKey_rotationExternal(prefix string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "bar" {
+  key_alias        = "tf-acc-test-kms-key-%s"
+  origin           = "external"
+  rotation_enabled = true
+}`, prefix)
+}
@@ -0,0 +1,51 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccLogTankV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogTankV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_logtank_group_v2.group", "id",
+						"opentelekomcloud_logtank_group_v2.testacc_group", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_logtank_topic_v2.topic", "id",
+						"opentelekomcloud_logtank_topic_v2.testacc_topic", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_logtank_group_v2.group", "ttl_in_days"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLogTankV2DataSource_basic = `
+resource "opentelekomcloud_logtank_group_v2" "testacc_group" {
+  group_name = "testacc_group"
+}
+
+resource "opentelekomcloud_logtank_topic_v2" "testacc_topic" {
+  group_id   = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_name = "testacc_topic"
+}
+
+data "opentelekomcloud_logtank_group_v2" "group" {
+  group_name = opentelekomcloud_logtank_group_v2.testacc_group.group_name
+}
+
+data "opentelekomcloud_logtank_topic_v2" "topic" {
+  group_id   = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_name = opentelekomcloud_logtank_topic_v2.testacc_topic.topic_name
+}
+`
@@ -0,0 +1,86 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceAccessConfigName = "opentelekomcloud_logtank_access_config_v2.config"
+
+func TestAccLogTankAccessConfigV2_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckLogTankGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogTankAccessConfigV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAccessConfigName, "type", "AGENT"),
+					resource.TestCheckResourceAttr(resourceAccessConfigName, "paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceAccessConfigName, "multiline.#", "0"),
+					resource.TestCheckResourceAttrPair(
+						resourceAccessConfigName, "topic_id",
+						"opentelekomcloud_logtank_topic_v2.testacc_topic", "id"),
+				),
+			},
+			{
+				Config: testAccLogTankAccessConfigV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAccessConfigName, "paths.#", "2"),
+					resource.TestCheckResourceAttr(resourceAccessConfigName, "black_paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceAccessConfigName, "multiline.0.mode", "regular"),
+				),
+			},
+			{
+				ResourceName:      resourceAccessConfigName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccLogTankAccessConfigV2_base = `
+resource "opentelekomcloud_logtank_group_v2" "testacc_group" {
+  group_name = "testacc_group"
+}
+
+resource "opentelekomcloud_logtank_topic_v2" "testacc_topic" {
+  group_id   = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_name = "testacc_topic"
+}
+
+resource "opentelekomcloud_logtank_host_group_v2" "group" {
+  name = "testacc_host_group"
+}
+`
+
+var testAccLogTankAccessConfigV2_basic = testAccLogTankAccessConfigV2_base + `
+resource "opentelekomcloud_logtank_access_config_v2" "config" {
+  name           = "testacc_access_config"
+  group_id       = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_id       = opentelekomcloud_logtank_topic_v2.testacc_topic.id
+  host_group_ids = [opentelekomcloud_logtank_host_group_v2.group.id]
+  paths          = ["/var/log/app/*.log"]
+}
+`
+
+var testAccLogTankAccessConfigV2_update = testAccLogTankAccessConfigV2_base + `
+resource "opentelekomcloud_logtank_access_config_v2" "config" {
+  name           = "testacc_access_config"
+  group_id       = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_id       = opentelekomcloud_logtank_topic_v2.testacc_topic.id
+  host_group_ids = [opentelekomcloud_logtank_host_group_v2.group.id]
+  paths          = ["/var/log/app/*.log", "/var/log/nginx/*.log"]
+  black_paths    = ["/var/log/app/debug.log"]
+
+  multiline {
+    mode  = "regular"
+    value = "^\\d{4}-\\d{2}-\\d{2}"
+  }
+}
+`
@@ -0,0 +1,67 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const resourceHostGroupName = "opentelekomcloud_logtank_host_group_v2.group"
+
+func TestAccLogTankHostGroupV2_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogTankHostGroupV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceHostGroupName, "name", "testacc_host_group"),
+					resource.TestCheckResourceAttr(resourceHostGroupName, "type", "linux"),
+					resource.TestCheckResourceAttr(resourceHostGroupName, "host_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceHostGroupName, "agent_access_type", "IP"),
+				),
+			},
+			{
+				Config: testAccLogTankHostGroupV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceHostGroupName, "name", "testacc_host_group_updated"),
+					resource.TestCheckResourceAttr(resourceHostGroupName, "host_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceHostGroupName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var testAccLogTankHostGroupV2_instance = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name              = "instance_1"
+  security_groups   = ["default"]
+  availability_zone = "%s"
+  network {
+    uuid = "%s"
+  }
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
+
+var testAccLogTankHostGroupV2_basic = testAccLogTankHostGroupV2_instance + `
+resource "opentelekomcloud_logtank_host_group_v2" "group" {
+  name     = "testacc_host_group"
+  host_ids = [opentelekomcloud_compute_instance_v2.instance_1.id]
+}
+`
+
+var testAccLogTankHostGroupV2_update = testAccLogTankHostGroupV2_instance + `
+resource "opentelekomcloud_logtank_host_group_v2" "group" {
+  name = "testacc_host_group_updated"
+}
+`
@@ -30,6 +30,15 @@ func TestAccLogTankGroupV2_basic(t *testing.T) {
 						"opentelekomcloud_logtank_group_v2.testacc_group", "group_name", "testacc_group"),
 				),
 			},
+			{
+				Config: testAccLogTankGroupV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogTankGroupV2Exists(
+						"opentelekomcloud_logtank_group_v2.testacc_group", &group),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_logtank_group_v2.testacc_group", "ttl_in_days", "14"),
+				),
+			},
 		},
 	})
 }
@@ -91,3 +100,10 @@ resource "opentelekomcloud_logtank_group_v2" "testacc_group" {
     group_name  = "testacc_group"
 }
 `
+
+const testAccLogTankGroupV2_update = `
+resource "opentelekomcloud_logtank_group_v2" "testacc_group" {
+    group_name  = "testacc_group"
+    ttl_in_days = 14
+}
+`
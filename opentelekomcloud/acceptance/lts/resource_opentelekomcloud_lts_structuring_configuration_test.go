@@ -0,0 +1,82 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceStructuringName = "opentelekomcloud_logtank_structuring_config_v2.structuring"
+
+func TestAccLogTankStructuringConfigV2_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckLogTankGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogTankStructuringConfigV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceStructuringName, "structure_type", "split"),
+					resource.TestCheckResourceAttr(resourceStructuringName, "fields.#", "2"),
+				),
+			},
+			{
+				Config: testAccLogTankStructuringConfigV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceStructuringName, "structure_type", "json"),
+					resource.TestCheckResourceAttr(resourceStructuringName, "json_layers", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceStructuringName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sample_log", "fields"},
+			},
+		},
+	})
+}
+
+const testAccLogTankStructuringConfigV2_base = `
+resource "opentelekomcloud_logtank_group_v2" "testacc_group" {
+  group_name = "testacc_group"
+}
+
+resource "opentelekomcloud_logtank_topic_v2" "testacc_topic" {
+  group_id   = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_name = "testacc_topic"
+}
+`
+
+var testAccLogTankStructuringConfigV2_basic = testAccLogTankStructuringConfigV2_base + `
+resource "opentelekomcloud_logtank_structuring_config_v2" "structuring" {
+  group_id       = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_id       = opentelekomcloud_logtank_topic_v2.testacc_topic.id
+  structure_type = "split"
+  split_char     = ","
+  sample_log     = "2021-04-01,INFO"
+
+  fields {
+    field_name = "time"
+    type       = "string"
+  }
+  fields {
+    field_name  = "level"
+    type        = "string"
+    is_analysis = true
+  }
+}
+`
+
+var testAccLogTankStructuringConfigV2_update = testAccLogTankStructuringConfigV2_base + `
+resource "opentelekomcloud_logtank_structuring_config_v2" "structuring" {
+  group_id       = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_id       = opentelekomcloud_logtank_topic_v2.testacc_topic.id
+  structure_type = "json"
+  json_layers    = 2
+  sample_log     = "{\"level\": \"INFO\", \"request\": {\"code\": 200}}"
+}
+`
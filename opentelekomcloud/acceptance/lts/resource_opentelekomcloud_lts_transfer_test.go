@@ -0,0 +1,96 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceTransferName = "opentelekomcloud_logtank_transfer_v2.transfer"
+
+func TestAccLogTankTransferV2_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("lts-transfer-%s", acctest.RandString(5))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckLogTankGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogTankTransferV2_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTransferName, "obs_bucket_name", bucketName),
+					resource.TestCheckResourceAttr(resourceTransferName, "period", "3"),
+					resource.TestCheckResourceAttr(resourceTransferName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccLogTankTransferV2_update(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTransferName, "period", "1"),
+					resource.TestCheckResourceAttr(resourceTransferName, "period_unit", "hour"),
+					resource.TestCheckResourceAttr(resourceTransferName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceTransferName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLogTankTransferV2_base(bucketName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket        = "%s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_logtank_group_v2" "testacc_group" {
+  group_name = "testacc_group"
+}
+
+resource "opentelekomcloud_logtank_topic_v2" "testacc_topic" {
+  group_id   = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_name = "testacc_topic"
+}
+`, bucketName)
+}
+
+func testAccLogTankTransferV2_basic(bucketName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_logtank_transfer_v2" "transfer" {
+  group_id        = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_ids       = [opentelekomcloud_logtank_topic_v2.testacc_topic.id]
+  obs_bucket_name = opentelekomcloud_obs_bucket.bucket.bucket
+  period          = 3
+  period_unit     = "hour"
+  dir_prefix_name = "lts"
+}
+`, testAccLogTankTransferV2_base(bucketName))
+}
+
+func testAccLogTankTransferV2_update(bucketName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_logtank_transfer_v2" "transfer" {
+  group_id        = opentelekomcloud_logtank_group_v2.testacc_group.id
+  topic_ids       = [opentelekomcloud_logtank_topic_v2.testacc_topic.id]
+  obs_bucket_name = opentelekomcloud_obs_bucket.bucket.bucket
+  period          = 1
+  period_unit     = "hour"
+  dir_prefix_name = "lts"
+  storage_format  = "JSON"
+  enabled         = false
+}
+`, testAccLogTankTransferV2_base(bucketName))
+}
@@ -31,6 +31,30 @@ func TestAccMRSV1Cluster_basic(t *testing.T) {
 						"opentelekomcloud_mrs_cluster_v1.cluster1", "cluster_state", "running"),
 				),
 			},
+			{
+				Config: testAccMRSV1ClusterConfigScaled(testAccMRSV1ClusterAutoScalingPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMRSV1ClusterExists("opentelekomcloud_mrs_cluster_v1.cluster1", &clusterGet),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_mrs_cluster_v1.cluster1", "core_node_num", "4"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_mrs_cluster_v1.cluster1", "task_node_groups.0.node_num", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_mrs_cluster_v1.cluster1", "task_node_groups.0.auto_scaling_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_mrs_cluster_v1.cluster1", "task_node_groups.0.auto_scaling_policy.0.rules.0.name", "default-expand-1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_mrs_cluster_v1.cluster1", "cluster_state", "running"),
+				),
+			},
+			{
+				Config: testAccMRSV1ClusterConfigScaled(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMRSV1ClusterExists("opentelekomcloud_mrs_cluster_v1.cluster1", &clusterGet),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_mrs_cluster_v1.cluster1", "task_node_groups.0.auto_scaling_policy.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -140,3 +164,86 @@ resource "opentelekomcloud_mrs_cluster_v1" "cluster1" {
     key = "value"
   }
 }`, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
+
+func testAccMRSV1ClusterConfigScaled(autoScalingPolicy string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_mrs_cluster_v1" "cluster1" {
+  cluster_name = "mrs-cluster-acc"
+  billing_type = 12
+  master_node_num = 2
+  core_node_num = 4
+  master_node_size = "h1.2xlarge.4.linux.mrs"
+  core_node_size = "h1.2xlarge.4.linux.mrs"
+  available_zone_id = "%s"
+  vpc_id = "%s"
+  subnet_id = "%s"
+  cluster_version = "MRS 1.7.2"
+  master_data_volume_type = "SAS"
+  master_data_volume_size = 100
+  master_data_volume_count = 1
+  core_data_volume_type = "SATA"
+  core_data_volume_size = 100
+  core_data_volume_count = 2
+  safe_mode = 0
+  cluster_type = 0
+  node_public_cert_name = "KeyPair-ci"
+  cluster_admin_secret = ""
+  component_list {
+      component_name = "Hadoop"
+  }
+  component_list {
+      component_name = "Spark"
+  }
+  component_list {
+      component_name = "Hive"
+  }
+  bootstrap_scripts {
+    name = "Modify os config"
+    uri = "s3a://bootstrap/modify_os_config.sh"
+    parameters = "param1 param2"
+    nodes = ["master", "core", "task"]
+	active_master = true
+	before_component_start = true
+    fail_action = "continue"
+  }
+  task_node_groups {
+    node_num          = 1
+    node_size         = "h1.2xlarge.4.linux.mrs"
+    data_volume_type  = "SATA"
+    data_volume_size  = 100
+    data_volume_count = 1
+%s
+  }
+  tags = {
+    foo = "bar"
+    key = "value"
+  }
+}`, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID, autoScalingPolicy)
+}
+
+const testAccMRSV1ClusterAutoScalingPolicy = `
+    auto_scaling_policy {
+      enabled      = true
+      min_capacity = 1
+      max_capacity = 3
+
+      resources_plans {
+        start_time   = "9:00"
+        end_time     = "18:00"
+        min_capacity = 1
+        max_capacity = 2
+      }
+
+      rules {
+        name               = "default-expand-1"
+        adjustment_type    = "scale_out"
+        cool_down_minutes  = 20
+        scaling_adjustment = 1
+        trigger {
+          metric_name         = "YARNMemoryAvailablePercentage"
+          metric_value        = "25"
+          comparison_operator = "LT"
+          evaluation_periods  = 10
+        }
+      }
+    }`
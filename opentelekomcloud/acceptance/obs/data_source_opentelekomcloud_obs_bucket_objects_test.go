@@ -0,0 +1,86 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccDataSourceObsBucketObjects_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.opentelekomcloud_obs_bucket_objects.objects"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceObsBucketObjects_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "logs/a.log"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.1", "logs/b.log"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.0", "logs/2021/"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", "STANDARD"),
+				),
+			},
+			{
+				Config: testAccDataSourceObsBucketObjects_maxKeys(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceObsBucketObjects_base(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+}
+
+resource "opentelekomcloud_obs_bucket_object" "objects" {
+  for_each = toset(["logs/a.log", "logs/b.log", "logs/2021/c.log", "logs/2021/d.log", "other.txt"])
+
+  bucket  = opentelekomcloud_obs_bucket.bucket.bucket
+  key     = each.value
+  content = "x"
+}
+`, randInt)
+}
+
+func testAccDataSourceObsBucketObjects_basic(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_obs_bucket_objects" "objects" {
+  bucket    = opentelekomcloud_obs_bucket.bucket.bucket
+  prefix    = "logs/"
+  delimiter = "/"
+
+  depends_on = [opentelekomcloud_obs_bucket_object.objects]
+}
+`, testAccDataSourceObsBucketObjects_base(randInt))
+}
+
+func testAccDataSourceObsBucketObjects_maxKeys(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_obs_bucket_objects" "objects" {
+  bucket   = opentelekomcloud_obs_bucket.bucket.bucket
+  prefix   = "logs/"
+  max_keys = 3
+
+  depends_on = [opentelekomcloud_obs_bucket_object.objects]
+}
+`, testAccDataSourceObsBucketObjects_base(randInt))
+}
@@ -0,0 +1,188 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccObsBucket_importFromS3(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucket_s3Bucket(rInt),
+			},
+			{
+				Config:            testAccObsBucket_s3Bucket(rInt),
+				ResourceName:      "opentelekomcloud_obs_bucket.bucket",
+				ImportState:       true,
+				ImportStateId:     testAccObsBucketName(rInt) + "/s3",
+				ImportStateCheck:  testAccCheckObsBucketImportedFromS3(testAccObsBucketName(rInt)),
+				ImportStateVerify: false,
+			},
+			{
+				Config:        testAccObsBucket_s3Bucket(rInt),
+				ResourceName:  "opentelekomcloud_obs_bucket.bucket",
+				ImportState:   true,
+				ImportStateId: testAccObsBucketName(rInt),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					// policy is imported only for migration from opentelekomcloud_s3_bucket
+					if len(states) != 1 {
+						return fmt.Errorf("expected only bucket to be imported, got %d resources", len(states))
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccObsBucket_importUnsupported(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucket_s3BucketExpirationDate(rInt),
+			},
+			{
+				Config:        testAccObsBucket_s3BucketExpirationDate(rInt),
+				ResourceName:  "opentelekomcloud_obs_bucket.bucket",
+				ImportState:   true,
+				ImportStateId: testAccObsBucketName(rInt) + "/s3",
+				ExpectError:   regexp.MustCompile(`lifecycle_rule "expire": expiration.date`),
+			},
+			{
+				Config:        testAccObsBucket_s3BucketExpirationDate(rInt),
+				ResourceName:  "opentelekomcloud_obs_bucket.bucket",
+				ImportState:   true,
+				ImportStateId: testAccObsBucketName(rInt) + "/s3/allow-unsupported",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+					if id := states[0].ID; id != testAccObsBucketName(rInt) {
+						return fmt.Errorf("expected ID %s, got %s", testAccObsBucketName(rInt), id)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketImportedFromS3(bucket string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 2 {
+			return fmt.Errorf("expected bucket and policy to be imported, got %d resources", len(states))
+		}
+
+		expected := map[string]map[string]string{
+			"opentelekomcloud_obs_bucket": {
+				"bucket":                        bucket,
+				"versioning":                    "true",
+				"cors_rule.0.allowed_origins.0": "https://www.example.com",
+				"cors_rule.0.max_age_seconds":   "3000",
+				"website.0.index_document":      "index.html",
+				"website.0.error_document":      "error.html",
+				"lifecycle_rule.0.name":         "logs",
+				"lifecycle_rule.0.prefix":       "logs/",
+			},
+			"opentelekomcloud_obs_bucket_policy": {
+				"bucket": bucket,
+			},
+		}
+		for _, state := range states {
+			attributes, ok := expected[state.Ephemeral.Type]
+			if !ok {
+				return fmt.Errorf("unexpected imported resource %s", state.Ephemeral.Type)
+			}
+			if state.ID != bucket {
+				return fmt.Errorf("expected ID of %s to be %s, got %s", state.Ephemeral.Type, bucket, state.ID)
+			}
+			for key, value := range attributes {
+				if actual := state.Attributes[key]; actual != value {
+					return fmt.Errorf("expected %s.%s to be %q, got %q", state.Ephemeral.Type, key, value, actual)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testAccObsBucket_s3Bucket(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%[1]d"
+  acl    = "public-read"
+
+  policy = <<POLICY
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": ["*"]},
+      "Action": ["s3:GetObject"],
+      "Resource": ["arn:aws:s3:::tf-test-bucket-%[1]d/*"]
+    }
+  ]
+}
+POLICY
+
+  versioning {
+    enabled = true
+  }
+
+  cors_rule {
+    allowed_origins = ["https://www.example.com"]
+    allowed_methods = ["GET", "PUT"]
+    max_age_seconds = 3000
+  }
+
+  website {
+    index_document = "index.html"
+    error_document = "error.html"
+  }
+
+  lifecycle_rule {
+    id      = "logs"
+    prefix  = "logs/"
+    enabled = true
+
+    expiration {
+      days = 30
+    }
+  }
+}
+`, randInt)
+}
+
+func testAccObsBucket_s3BucketExpirationDate(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle_rule {
+    id      = "expire"
+    enabled = true
+
+    expiration {
+      date = "2030-01-01"
+    }
+  }
+}
+`, randInt)
+}
@@ -0,0 +1,160 @@
+package acceptance
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccObsBucketDirectory_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-acc-obs-dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeFile := func(name, content string) {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "hello")
+	writeFile("css/style.css", "body {}")
+
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket_directory.site"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckObsBucketDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketDirectory_basic(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", "5d41402abc4b2a76b9719d911017c592"),
+					testAccCheckObsBucketDirectoryContentType(resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckObsBucketDirectoryContentType(resourceName, "site/css/style.css", "text/x-custom-css"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "hello, world")
+					writeFile("js/app.js", "")
+					if err := os.RemoveAll(filepath.Join(dir, "css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObsBucketDirectory_basic(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", "e4d7f1b4ed2e42d15898f4b27b019da4"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/js/app.js", "d41d8cd98f00b204e9800998ecf8427e"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/css/style.css"),
+					testAccCheckObsBucketObjectExists("opentelekomcloud_obs_bucket_object.other"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketDirectoryContentType(n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		obsClient, err := config.NewObjectStorageClient(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud OBS client: %s", err)
+		}
+
+		output, err := obsClient.GetObjectMetadata(&obs.GetObjectMetadataInput{
+			Bucket: rs.Primary.Attributes["bucket"],
+			Key:    key,
+		})
+		if err != nil {
+			return fmt.Errorf("error getting metadata of %s: %s", key, err)
+		}
+		if output.ContentType != contentType {
+			return fmt.Errorf("expected content type of %s to be %q, got %q", key, contentType, output.ContentType)
+		}
+		return nil
+	}
+}
+
+func testAccCheckObsBucketDirectoryDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	obsClient, err := config.NewObjectStorageClient(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_obs_bucket_directory" {
+			continue
+		}
+
+		bucket := rs.Primary.Attributes["bucket"]
+		input := &obs.ListObjectsInput{}
+		input.Bucket = bucket
+		input.Prefix = rs.Primary.Attributes["prefix"]
+
+		resp, err := obsClient.ListObjects(input)
+		if err != nil {
+			if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "NoSuchBucket" {
+				return nil
+			}
+			return fmt.Errorf("error listing objects of OBS bucket %s: %s", bucket, err)
+		}
+		if len(resp.Contents) > 0 {
+			return fmt.Errorf("OBS directory %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccObsBucketDirectory_basic(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+}
+
+# objects not uploaded by the directory are kept
+resource "opentelekomcloud_obs_bucket_object" "other" {
+  bucket  = opentelekomcloud_obs_bucket.bucket.bucket
+  key     = "site/other.txt"
+  content = "other"
+}
+
+resource "opentelekomcloud_obs_bucket_directory" "site" {
+  bucket = opentelekomcloud_obs_bucket.bucket.bucket
+  prefix = "site"
+  source = "%s"
+
+  content_types = {
+    css = "text/x-custom-css"
+  }
+
+  depends_on = [opentelekomcloud_obs_bucket_object.other]
+}
+`, randInt, source)
+}
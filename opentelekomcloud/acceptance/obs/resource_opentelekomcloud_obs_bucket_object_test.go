@@ -1,6 +1,7 @@
 package acceptance
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -61,6 +62,49 @@ func TestAccObsBucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccObsBucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-obs-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// write 12 MB to the tempfile to get 3 parts of 5 MB
+	err = ioutil.WriteFile(tmpFile.Name(), bytes.Repeat([]byte("0123456789abcdef"), 12*1024*1024/16), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceName := "opentelekomcloud_obs_bucket_object.object"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckObsBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObject_configMultipart(rInt, tmpFile.Name(), "private", "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketObjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size", "12582912"),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "source_md5", "6114474bb68ffaf28d2827d74f1339cf"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "max-age=3600"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.owner", "terraform"),
+				),
+			},
+			{
+				Config: testAccObsBucketObject_configMultipart(rInt, tmpFile.Name(), "public-read", "WARM"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "acl", "public-read"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "WARM"),
+					resource.TestCheckResourceAttr(resourceName, "source_md5", "6114474bb68ffaf28d2827d74f1339cf"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccObsBucketObject_content(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -231,6 +275,31 @@ resource "opentelekomcloud_obs_bucket_object" "object" {
 `, randInt, source)
 }
 
+func testAccObsBucketObject_configMultipart(randInt int, source, acl, class string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%[1]d"
+}
+
+resource "opentelekomcloud_obs_bucket_object" "object" {
+  bucket              = opentelekomcloud_obs_bucket.object_bucket.bucket
+  key                 = "test-key"
+  source              = "%[2]s"
+  source_hash         = filesha256("%[2]s")
+  acl                 = "%[3]s"
+  storage_class       = "%[4]s"
+  cache_control       = "max-age=3600"
+  multipart_threshold = 5
+  part_size           = 5
+  concurrency         = 2
+
+  metadata = {
+    owner = "terraform"
+  }
+}
+`, randInt, source, acl, class)
+}
+
 func testAccObsBucketObject_configContent(randInt int) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_obs_bucket" "object_bucket" {
@@ -2,11 +2,13 @@ package acceptance
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
@@ -216,6 +218,109 @@ func TestAccObsBucket_cors(t *testing.T) {
 	})
 }
 
+func TestAccObsBucket_encryption(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigWithEncryption(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.0.algorithm", "kms"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "server_side_encryption.0.kms_key_id",
+						"opentelekomcloud_kms_key_v1.key", "id"),
+				),
+			},
+			{
+				Config: testAccObsBucket_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObsBucket_notifications(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigWithNotifications(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_notifications.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_notifications.0.id", "created"),
+					resource.TestCheckResourceAttr(resourceName, "event_notifications.0.events.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "event_notifications.0.filter_rule.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "event_notifications.0.topic",
+						"opentelekomcloud_smn_topic_v2.topic", "topic_urn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObsBucket_forceDestroy(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigWithForceDestroy(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					testAccCheckObsBucketAddObjects(resourceName, 1100),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObsBucket_forceDestroySuspendedVersioning(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigWithForceDestroy(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					testAccCheckObsBucketAddObjects(resourceName, 100),
+				),
+			},
+			{
+				// objects put into the bucket with suspended versioning have `null` version
+				Config: testAccObsBucketConfigWithForceDestroy(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "versioning", "false"),
+					testAccCheckObsBucketAddObjects(resourceName, 100),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObsBucketDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	obsClient, err := config.NewObjectStorageClient(env.OS_REGION_NAME)
@@ -292,6 +397,58 @@ func testAccCheckObsBucketLogging(name, target, prefix string) resource.TestChec
 	}
 }
 
+// testAccCheckObsBucketAddObjects fills the bucket with more objects than fit into a single
+// list page, adds a second version and a delete marker for some of them and starts a multipart upload
+func testAccCheckObsBucketAddObjects(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		obsClient, err := config.NewObjectStorageClient(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud OBS client: %s", err)
+		}
+
+		bucket := rs.Primary.ID
+		for i := 0; i < count; i++ {
+			input := &obs.PutObjectInput{}
+			input.Bucket = bucket
+			input.Key = fmt.Sprintf("objects/%d", i)
+			input.Body = strings.NewReader("content")
+			if _, err := obsClient.PutObject(input); err != nil {
+				return fmt.Errorf("error putting object %s: %s", input.Key, err)
+			}
+		}
+		for i := 0; i < 10; i++ {
+			input := &obs.PutObjectInput{}
+			input.Bucket = bucket
+			input.Key = fmt.Sprintf("objects/%d", i)
+			input.Body = strings.NewReader("new content")
+			if _, err := obsClient.PutObject(input); err != nil {
+				return fmt.Errorf("error putting object %s: %s", input.Key, err)
+			}
+			_, err := obsClient.DeleteObject(&obs.DeleteObjectInput{
+				Bucket: bucket,
+				Key:    fmt.Sprintf("objects/%d", count-i-1),
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting object: %s", err)
+			}
+		}
+
+		upload := &obs.InitiateMultipartUploadInput{}
+		upload.Bucket = bucket
+		upload.Key = "multipart"
+		if _, err := obsClient.InitiateMultipartUpload(upload); err != nil {
+			return fmt.Errorf("error initiating multipart upload: %s", err)
+		}
+		return nil
+	}
+}
+
 // These need a bit of randomness as the name can only be used once globally
 func testAccObsBucketName(randInt int) string {
 	return fmt.Sprintf("tf-test-bucket-%d", randInt)
@@ -346,6 +503,60 @@ resource "opentelekomcloud_obs_bucket" "bucket" {
 `, randInt)
 }
 
+func testAccObsBucketConfigWithEncryption(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key" {
+  key_alias    = "tf-test-key-%d"
+  pending_days    = "7"
+}
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+
+  server_side_encryption {
+    algorithm  = "kms"
+    kms_key_id = opentelekomcloud_kms_key_v1.key.id
+  }
+}
+`, randInt, randInt)
+}
+
+func testAccObsBucketConfigWithNotifications(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic" {
+  name = "tf_test_topic_%d"
+}
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+
+  event_notifications {
+    id     = "created"
+    topic  = opentelekomcloud_smn_topic_v2.topic.topic_urn
+    events = ["ObjectCreated:Put", "ObjectCreated:Post"]
+
+    filter_rule {
+      name  = "prefix"
+      value = "upload/"
+    }
+  }
+}
+`, randInt, randInt)
+}
+
+func testAccObsBucketConfigWithForceDestroy(randInt int, versioning bool) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%d"
+  acl           = "private"
+  versioning    = %t
+  force_destroy = true
+}
+`, randInt, versioning)
+}
+
 func testAccObsBucketConfigWithDisableVersioning(randInt int) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_obs_bucket" "bucket" {
@@ -0,0 +1,82 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceMessageTemplateName = "opentelekomcloud_smn_message_template_v2.template_1"
+
+func TestAccSMNV2MessageTemplate_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckSMNV2MessageTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMNV2MessageTemplateConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceMessageTemplateName, "name", "template_1"),
+					resource.TestCheckResourceAttr(resourceMessageTemplateName, "protocol", "email"),
+					resource.TestCheckResourceAttr(resourceMessageTemplateName, "tag_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceMessageTemplateName, "tag_names.0", "service"),
+				),
+			},
+			{
+				Config: testAccSMNV2MessageTemplateConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceMessageTemplateName, "tag_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceMessageTemplateName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSMNV2MessageTemplateDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.SmnV2Client(env.OS_TENANT_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_smn_message_template_v2" {
+			continue
+		}
+
+		_, err := client.Get(client.ServiceURL("message_template", rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Message template still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccSMNV2MessageTemplateConfigBasic = `
+resource "opentelekomcloud_smn_message_template_v2" "template_1" {
+  name     = "template_1"
+  protocol = "email"
+  content  = "Service {service} is unavailable"
+}
+`
+
+const testAccSMNV2MessageTemplateConfigUpdate = `
+resource "opentelekomcloud_smn_message_template_v2" "template_1" {
+  name     = "template_1"
+  protocol = "email"
+  content  = "Service {service} is unavailable in {region}"
+}
+`
@@ -0,0 +1,91 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceTopicAttributeName = "opentelekomcloud_smn_topic_attribute_v2.policy"
+
+func TestAccSMNV2TopicAttribute_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { common.TestAccPreCheck(t) },
+		Providers:    common.TestAccProviders,
+		CheckDestroy: testAccCheckSMNV2TopicAttributeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMNV2TopicAttributeConfig("OBS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTopicAttributeName, "attribute_name", "access_policy"),
+					resource.TestCheckResourceAttrPair(resourceTopicAttributeName, "topic_urn",
+						"opentelekomcloud_smn_topic_v2.topic_1", "id"),
+				),
+			},
+			{
+				Config: testAccSMNV2TopicAttributeConfig("CTS"),
+			},
+			{
+				ResourceName:      resourceTopicAttributeName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSMNV2TopicAttributeDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.SmnV2Client(env.OS_TENANT_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_smn_topic_attribute_v2" {
+			continue
+		}
+
+		var result struct {
+			Attributes map[string]string `json:"attributes"`
+		}
+		url := client.ServiceURL("topics", rs.Primary.Attributes["topic_urn"], "attributes") +
+			"?name=" + rs.Primary.Attributes["attribute_name"]
+		if _, err := client.Get(url, &result, nil); err == nil && result.Attributes[rs.Primary.Attributes["attribute_name"]] != "" {
+			return fmt.Errorf("Topic attribute still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccSMNV2TopicAttributeConfig(service string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name         = "topic_attribute"
+  display_name = "The display name of topic_attribute"
+}
+
+resource "opentelekomcloud_smn_topic_attribute_v2" "policy" {
+  topic_urn      = opentelekomcloud_smn_topic_v2.topic_1.id
+  attribute_name = "access_policy"
+  value = jsonencode({
+    Version = "2016-09-07"
+    Id      = "__default_policy_ID"
+    Statement = [{
+      Sid       = "__service_pub_0"
+      Effect    = "Allow"
+      Principal = { Service = ["%s"] }
+      Action    = ["SMN:Publish", "SMN:QueryTopicDetail"]
+      Resource  = opentelekomcloud_smn_topic_v2.topic_1.id
+    }]
+  })
+}
+`, service)
+}
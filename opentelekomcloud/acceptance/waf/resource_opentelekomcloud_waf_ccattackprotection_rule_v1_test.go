@@ -30,6 +30,21 @@ func TestAccWafCcAttackProtectionRuleV1_basic(t *testing.T) {
 						"opentelekomcloud_waf_ccattackprotection_rule_v1.rule_1", "url", "/abc1"),
 				),
 			},
+			{
+				Config: testAccWafCcAttackProtectionRuleV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWafCcAttackProtectionRuleV1Exists("opentelekomcloud_waf_ccattackprotection_rule_v1.rule_1", &rule),
+					resource.TestCheckResourceAttr("opentelekomcloud_waf_ccattackprotection_rule_v1.rule_1", "url", "/abc2"),
+					resource.TestCheckResourceAttr("opentelekomcloud_waf_ccattackprotection_rule_v1.rule_1", "limit_num", "20"),
+					resource.TestCheckResourceAttr("opentelekomcloud_waf_ccattackprotection_rule_v1.rule_1", "lock_time", "20"),
+				),
+			},
+			{
+				ResourceName:      "opentelekomcloud_waf_ccattackprotection_rule_v1.rule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc("opentelekomcloud_waf_ccattackprotection_rule_v1.rule_1"),
+			},
 		},
 	})
 }
@@ -105,3 +120,22 @@ resource "opentelekomcloud_waf_ccattackprotection_rule_v1" "rule_1" {
 	block_content = "{\"error\":\"forbidden\"}"
 }
 `
+
+const testAccWafCcAttackProtectionRuleV1_update = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+	name = "policy_1"
+}
+
+resource "opentelekomcloud_waf_ccattackprotection_rule_v1" "rule_1" {
+	policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+	url = "/abc2"
+	limit_num = 20
+	limit_period = 60
+	lock_time = 20
+	tag_type = "cookie"
+	tag_index = "sessionid"
+	action_category = "block"
+	block_content_type = "application/json"
+	block_content = "{\"error\":\"forbidden\"}"
+}
+`
@@ -46,6 +46,12 @@ func TestAccWafDataMaskingRuleV1_basic(t *testing.T) {
 						"opentelekomcloud_waf_datamasking_rule_v1.rule_1", "index", "password"),
 				),
 			},
+			{
+				ResourceName:      "opentelekomcloud_waf_datamasking_rule_v1.rule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc("opentelekomcloud_waf_datamasking_rule_v1.rule_1"),
+			},
 		},
 	})
 }
@@ -0,0 +1,163 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const (
+	resourceWafDedicatedInstanceName = "opentelekomcloud_waf_dedicated_instance.instance_1"
+	resourceWafDedicatedDomainName   = "opentelekomcloud_waf_dedicated_domain.domain_1"
+)
+
+func TestAccWafDedicatedInstance_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedInstance_basic("waf-dedicated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafDedicatedInstanceName, "name", "waf-dedicated"),
+					resource.TestCheckResourceAttr(resourceWafDedicatedInstanceName, "run_status", "1"),
+					resource.TestCheckResourceAttrSet(resourceWafDedicatedInstanceName, "service_ip"),
+				),
+			},
+			{
+				Config: testAccWafDedicatedInstance_basic("waf-dedicated-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafDedicatedInstanceName, "name", "waf-dedicated-updated"),
+				),
+			},
+			{
+				ResourceName:      resourceWafDedicatedInstanceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWafDedicatedDomain_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafDedicatedDomain_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafDedicatedDomainName, "domain", "www.waf-dedicated.com"),
+					resource.TestCheckResourceAttr(resourceWafDedicatedDomainName, "server.#", "1"),
+					resource.TestCheckResourceAttr(resourceWafDedicatedDomainName, "protect_status", "1"),
+					resource.TestCheckResourceAttrSet(resourceWafDedicatedDomainName, "policy_id"),
+				),
+			},
+			{
+				Config: testAccWafDedicatedDomain_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafDedicatedDomainName, "server.0.port", "8443"),
+					resource.TestCheckResourceAttr(resourceWafDedicatedDomainName, "proxy", "true"),
+					resource.TestCheckResourceAttr(resourceWafDedicatedDomainName, "protect_status", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceWafDedicatedDomainName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keep_policy"},
+			},
+		},
+	})
+}
+
+func testAccWafDedicatedInstance_basic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name = "waf-dedicated-secgroup"
+}
+
+resource "opentelekomcloud_waf_dedicated_instance" "instance_1" {
+  name              = "%s"
+  availability_zone = "%s"
+  specification     = "waf.instance.professional"
+  flavor            = "s3.2xlarge.2"
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group    = [opentelekomcloud_networking_secgroup_v2.secgroup_1.id]
+}
+`, name, env.OS_AVAILABILITY_ZONE, env.OS_VPC_ID, env.OS_NETWORK_ID)
+}
+
+// domain traffic comes to the dedicated engine through the ELB listener
+var testAccWafDedicatedDomain_base = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "waf-loadbalancer"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "waf-listener"
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name        = "waf-pool"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+
+resource "opentelekomcloud_lb_member_v2" "member_1" {
+  address       = opentelekomcloud_waf_dedicated_instance.instance_1.service_ip
+  protocol_port = 80
+  pool_id       = opentelekomcloud_lb_pool_v2.pool_1.id
+  subnet_id     = "%s"
+}
+`, testAccWafDedicatedInstance_basic("waf-dedicated"), env.OS_SUBNET_ID, env.OS_SUBNET_ID)
+
+var testAccWafDedicatedDomain_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_waf_dedicated_domain" "domain_1" {
+  domain = "www.waf-dedicated.com"
+
+  server {
+    client_protocol = "HTTP"
+    server_protocol = "HTTP"
+    address         = "192.168.0.10"
+    port            = 8080
+    vpc_id          = "%s"
+  }
+
+  depends_on = [opentelekomcloud_lb_member_v2.member_1]
+}
+`, testAccWafDedicatedDomain_base, env.OS_VPC_ID)
+
+var testAccWafDedicatedDomain_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_waf_dedicated_domain" "domain_1" {
+  domain         = "www.waf-dedicated.com"
+  proxy          = true
+  protect_status = 0
+
+  server {
+    client_protocol = "HTTP"
+    server_protocol = "HTTP"
+    address         = "192.168.0.10"
+    port            = 8443
+    vpc_id          = "%s"
+  }
+
+  depends_on = [opentelekomcloud_lb_member_v2.member_1]
+}
+`, testAccWafDedicatedDomain_base, env.OS_VPC_ID)
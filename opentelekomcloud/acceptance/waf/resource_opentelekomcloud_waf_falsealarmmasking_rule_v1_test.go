@@ -32,6 +32,20 @@ func TestAccWafFalseAlarmMaskingRuleV1_basic(t *testing.T) {
 						"opentelekomcloud_waf_falsealarmmasking_rule_v1.rule_1", "rule", "100001"),
 				),
 			},
+			{
+				Config: testAccWafFalseAlarmMaskingRuleV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWafFalseAlarmMaskingRuleV1Exists("opentelekomcloud_waf_falsealarmmasking_rule_v1.rule_1", &rule),
+					resource.TestCheckResourceAttr("opentelekomcloud_waf_falsealarmmasking_rule_v1.rule_1", "url", "/b"),
+					resource.TestCheckResourceAttr("opentelekomcloud_waf_falsealarmmasking_rule_v1.rule_1", "rule", "100002"),
+				),
+			},
+			{
+				ResourceName:      "opentelekomcloud_waf_falsealarmmasking_rule_v1.rule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc("opentelekomcloud_waf_falsealarmmasking_rule_v1.rule_1"),
+			},
 		},
 	})
 }
@@ -104,3 +118,15 @@ resource "opentelekomcloud_waf_falsealarmmasking_rule_v1" "rule_1" {
 	rule = "100001"
 }
 `
+
+const testAccWafFalseAlarmMaskingRuleV1_update = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+	name = "policy_1"
+}
+
+resource "opentelekomcloud_waf_falsealarmmasking_rule_v1" "rule_1" {
+	policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+	url = "/b"
+	rule = "100002"
+}
+`
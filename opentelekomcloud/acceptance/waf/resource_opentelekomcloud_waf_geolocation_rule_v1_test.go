@@ -0,0 +1,66 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceWafGeolocationRuleName = "opentelekomcloud_waf_geolocation_rule_v1.rule_1"
+
+func TestAccWafGeolocationRuleV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafGeolocationRuleV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafGeolocationRuleName, "name", "geo-rule"),
+					resource.TestCheckResourceAttr(resourceWafGeolocationRuleName, "geoip.#", "1"),
+					resource.TestCheckResourceAttr(resourceWafGeolocationRuleName, "white", "0"),
+				),
+			},
+			{
+				Config: testAccWafGeolocationRuleV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafGeolocationRuleName, "geoip.#", "2"),
+					resource.TestCheckResourceAttr(resourceWafGeolocationRuleName, "white", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceWafGeolocationRuleName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc(resourceWafGeolocationRuleName),
+			},
+		},
+	})
+}
+
+const testAccWafGeolocationRuleV1_basic = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+  name = "policy_geo"
+}
+
+resource "opentelekomcloud_waf_geolocation_rule_v1" "rule_1" {
+  policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+  name      = "geo-rule"
+  geoip     = ["BR"]
+}
+`
+
+const testAccWafGeolocationRuleV1_update = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+  name = "policy_geo"
+}
+
+resource "opentelekomcloud_waf_geolocation_rule_v1" "rule_1" {
+  policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+  name      = "geo-rule"
+  geoip     = ["BR", "FR"]
+  white     = 1
+}
+`
@@ -30,6 +30,21 @@ func TestAccWafPreciseProtectionRuleV1_basic(t *testing.T) {
 						"opentelekomcloud_waf_preciseprotection_rule_v1.rule_1", "name", "rule_1"),
 				),
 			},
+			{
+				Config: testAccWafPreciseProtectionRuleV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWafPreciseProtectionRuleV1Exists("opentelekomcloud_waf_preciseprotection_rule_v1.rule_1", &rule),
+					resource.TestCheckResourceAttr("opentelekomcloud_waf_preciseprotection_rule_v1.rule_1", "name", "rule_updated"),
+					resource.TestCheckResourceAttr("opentelekomcloud_waf_preciseprotection_rule_v1.rule_1", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("opentelekomcloud_waf_preciseprotection_rule_v1.rule_1", "priority", "20"),
+				),
+			},
+			{
+				ResourceName:      "opentelekomcloud_waf_preciseprotection_rule_v1.rule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc("opentelekomcloud_waf_preciseprotection_rule_v1.rule_1"),
+			},
 		},
 	})
 }
@@ -109,3 +124,21 @@ resource "opentelekomcloud_waf_preciseprotection_rule_v1" "rule_1" {
 	priority = 10
 }
 `
+
+const testAccWafPreciseProtectionRuleV1_update = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+	name = "policy_1"
+}
+
+resource "opentelekomcloud_waf_preciseprotection_rule_v1" "rule_1" {
+	policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+	name = "rule_updated"
+	conditions {
+		category = "url"
+		contents = ["/logout"]
+		logic = 1
+	}
+	action_category = "pass"
+	priority = 20
+}
+`
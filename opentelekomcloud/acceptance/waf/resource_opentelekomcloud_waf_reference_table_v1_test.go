@@ -0,0 +1,94 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const resourceWafReferenceTableName = "opentelekomcloud_waf_reference_table_v1.table_1"
+
+func TestAccWafReferenceTableV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafReferenceTableV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafReferenceTableName, "name", "reference_table"),
+					resource.TestCheckResourceAttr(resourceWafReferenceTableName, "type", "url"),
+					resource.TestCheckResourceAttr(resourceWafReferenceTableName, "conditions.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_waf_preciseprotection_rule_v1.rule_1", "conditions.0.reference_table_id",
+						resourceWafReferenceTableName, "id"),
+				),
+			},
+			{
+				Config: testAccWafReferenceTableV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafReferenceTableName, "conditions.#", "3"),
+					resource.TestCheckResourceAttr(resourceWafReferenceTableName, "description", "updated"),
+				),
+			},
+			{
+				ResourceName:      resourceWafReferenceTableName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccWafReferenceTableV1_basic = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+  name = "policy_reference"
+}
+
+resource "opentelekomcloud_waf_reference_table_v1" "table_1" {
+  name       = "reference_table"
+  type       = "url"
+  conditions = ["/admin", "/manage"]
+}
+
+resource "opentelekomcloud_waf_preciseprotection_rule_v1" "rule_1" {
+  policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+  name      = "rule_reference"
+
+  conditions {
+    category           = "url"
+    logic              = 1
+    reference_table_id = opentelekomcloud_waf_reference_table_v1.table_1.id
+  }
+  action_category = "block"
+  priority        = 10
+}
+`
+
+const testAccWafReferenceTableV1_update = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+  name = "policy_reference"
+}
+
+resource "opentelekomcloud_waf_reference_table_v1" "table_1" {
+  name        = "reference_table"
+  type        = "url"
+  conditions  = ["/admin", "/manage", "/console"]
+  description = "updated"
+}
+
+resource "opentelekomcloud_waf_preciseprotection_rule_v1" "rule_1" {
+  policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+  name      = "rule_reference"
+
+  conditions {
+    category           = "url"
+    logic              = 1
+    reference_table_id = opentelekomcloud_waf_reference_table_v1.table_1.id
+  }
+  action_category = "block"
+  priority        = 10
+}
+`
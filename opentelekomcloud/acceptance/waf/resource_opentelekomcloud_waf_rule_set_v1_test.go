@@ -0,0 +1,170 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/whiteblackip_rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceWafRuleSetName = "opentelekomcloud_waf_rule_set_v1.rule_set"
+
+func TestAccWafRuleSetV1_basic(t *testing.T) {
+	var policyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { common.TestAccPreCheck(t) },
+		Providers: common.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafRuleSetV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafRuleSetName, "rule_ids.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceWafRuleSetName, "rule_ids.office"),
+					resource.TestCheckResourceAttr(resourceWafRuleSetName, "unmanaged_rules.#", "0"),
+				),
+			},
+			{
+				Config: testAccWafRuleSetV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafRuleSetName, "rule_ids.%", "2"),
+					resource.TestCheckNoResourceAttr(resourceWafRuleSetName, "rule_ids.geo"),
+					testAccCheckWafRuleSetV1PolicyID(&policyID),
+				),
+			},
+			{
+				PreConfig: testAccWafRuleSetV1CreateUnmanagedRule(t, &policyID),
+				Config:    testAccWafRuleSetV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafRuleSetName, "unmanaged_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceWafRuleSetName, "unmanaged_rules.0.type", "whiteblackip"),
+				),
+			},
+			{
+				Config: testAccWafRuleSetV1_removeUnmanaged,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceWafRuleSetName, "unmanaged_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWafRuleSetV1PolicyID(policyID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceWafRuleSetName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceWafRuleSetName)
+		}
+		*policyID = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccWafRuleSetV1CreateUnmanagedRule creates a rule outside of terraform
+func testAccWafRuleSetV1CreateUnmanagedRule(t *testing.T, policyID *string) func() {
+	return func() {
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		wafClient, err := config.WafV1Client(env.OS_REGION_NAME)
+		if err != nil {
+			t.Fatalf("Error creating OpenTelekomCloud WAF client: %s", err)
+		}
+		createOpts := whiteblackip_rules.CreateOpts{
+			Addr: "192.168.1.125",
+		}
+		if _, err := whiteblackip_rules.Create(wafClient, *policyID, createOpts).Extract(); err != nil {
+			t.Fatalf("Error creating unmanaged WAF rule: %s", err)
+		}
+	}
+}
+
+const testAccWafRuleSetV1_basic = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+  name = "policy_rule_set"
+}
+
+resource "opentelekomcloud_waf_rule_set_v1" "rule_set" {
+  policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+  rules     = <<EOT
+- key: office
+  type: whiteblackip
+  addr: 192.168.0.0/24
+  white: 1
+- key: geo
+  type: geoip
+  geoip: [BR]
+- key: login
+  type: precise
+  name: login
+  action: block
+  priority: 10
+  conditions:
+    - category: url
+      logic: 1
+      contents: [/login]
+EOT
+}
+`
+
+const testAccWafRuleSetV1_update = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+  name = "policy_rule_set"
+}
+
+resource "opentelekomcloud_waf_rule_set_v1" "rule_set" {
+  policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+  rules = jsonencode([
+    {
+      key   = "office"
+      type  = "whiteblackip"
+      addr  = "192.168.0.0/24"
+      white = 0
+    },
+    {
+      key      = "login"
+      type     = "precise"
+      name     = "login"
+      action   = "pass"
+      priority = 10
+      conditions = [
+        { category = "url", logic = 1, contents = ["/login"] },
+      ]
+    },
+  ])
+}
+`
+
+const testAccWafRuleSetV1_removeUnmanaged = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+  name = "policy_rule_set"
+}
+
+resource "opentelekomcloud_waf_rule_set_v1" "rule_set" {
+  policy_id        = opentelekomcloud_waf_policy_v1.policy_1.id
+  remove_unmanaged = true
+  rules = jsonencode([
+    {
+      key   = "office"
+      type  = "whiteblackip"
+      addr  = "192.168.0.0/24"
+      white = 0
+    },
+    {
+      key      = "login"
+      type     = "precise"
+      name     = "login"
+      action   = "pass"
+      priority = 10
+      conditions = [
+        { category = "url", logic = 1, contents = ["/login"] },
+      ]
+    },
+  ])
+}
+`
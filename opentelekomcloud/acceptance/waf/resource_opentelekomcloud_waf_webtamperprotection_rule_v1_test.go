@@ -32,6 +32,19 @@ func TestAccWebTamperProtectionRuleV1_basic(t *testing.T) {
 						"opentelekomcloud_waf_webtamperprotection_rule_v1.rule_1", "url", "/a"),
 				),
 			},
+			{
+				Config: testAccWafWebTamperProtectionRuleV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebTamperProtectionRuleV1Exists("opentelekomcloud_waf_webtamperprotection_rule_v1.rule_1", &rule),
+					resource.TestCheckResourceAttr("opentelekomcloud_waf_webtamperprotection_rule_v1.rule_1", "url", "/b"),
+				),
+			},
+			{
+				ResourceName:      "opentelekomcloud_waf_webtamperprotection_rule_v1.rule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc("opentelekomcloud_waf_webtamperprotection_rule_v1.rule_1"),
+			},
 		},
 	})
 }
@@ -99,3 +112,15 @@ resource "opentelekomcloud_waf_webtamperprotection_rule_v1" "rule_1" {
 	url = "/a"
 }
 `
+
+const testAccWafWebTamperProtectionRuleV1_update = `
+resource "opentelekomcloud_waf_policy_v1" "policy_1" {
+	name = "policy_updated"
+}
+
+resource "opentelekomcloud_waf_webtamperprotection_rule_v1" "rule_1" {
+	policy_id = opentelekomcloud_waf_policy_v1.policy_1.id
+	hostname = "www.abc.com"
+	url = "/b"
+}
+`
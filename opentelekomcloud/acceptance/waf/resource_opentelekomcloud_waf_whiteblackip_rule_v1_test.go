@@ -42,6 +42,12 @@ func TestAccWafWhiteBlackIpRuleV1_basic(t *testing.T) {
 						"opentelekomcloud_waf_whiteblackip_rule_v1.rule_1", "white", "1"),
 				),
 			},
+			{
+				ResourceName:      "opentelekomcloud_waf_whiteblackip_rule_v1.rule_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc("opentelekomcloud_waf_whiteblackip_rule_v1.rule_1"),
+			},
 		},
 	})
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -617,6 +618,16 @@ func (c *Config) setupTemporaryCredentials() error {
 }
 
 func (c *Config) NewObjectStorageClient(region string) (*obs.ObsClient, error) {
+	return c.newObjectStorageClient(region)
+}
+
+// NewObjectStorageClientWithSignature creates OBS client using given signature type.
+// `obs.SignatureV4` allows signing requests to the bucket sub-resources unknown to OBS SDK.
+func (c *Config) NewObjectStorageClientWithSignature(region string, signature obs.SignatureType) (*obs.ObsClient, error) {
+	return c.newObjectStorageClient(region, obs.WithSignature(signature), obs.WithRegion(c.determineRegion(region)))
+}
+
+func (c *Config) newObjectStorageClient(region string, configurers ...obs.Configurer) (*obs.ObsClient, error) {
 	if err := c.setupTemporaryCredentials(); err != nil {
 		return nil, fmt.Errorf("failed to construct OBS client without AK/SK: %s", err)
 	}
@@ -631,7 +642,8 @@ func (c *Config) NewObjectStorageClient(region string) (*obs.ObsClient, error) {
 
 	setUpOBSLogging()
 
-	return obs.New(c.AccessKey, c.SecretKey, client.Endpoint, obs.WithSecurityToken(c.SecurityToken))
+	configurers = append(configurers, obs.WithSecurityToken(c.SecurityToken))
+	return obs.New(c.AccessKey, c.SecretKey, client.Endpoint, configurers...)
 }
 
 func (c *Config) blockStorageV1Client(region string) (*golangsdk.ServiceClient, error) {
@@ -747,6 +759,27 @@ func (c *Config) CesV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// CesV2Client returns a client for CES v2 API, sharing the CES v1 endpoint
+func (c *Config) CesV2Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.CesV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	return withVersion(client, "v2")
+}
+
+// withVersion replaces version and project parts of the client endpoint,
+// e.g. `https://ces.{region}.{domain}/V1.0/{project_id}/` becomes `https://ces.{region}.{domain}/v2/{project_id}/`
+func withVersion(client *golangsdk.ServiceClient, version string) (*golangsdk.ServiceClient, error) {
+	u, err := url.Parse(client.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s endpoint: %s", client.Type, err)
+	}
+	client.Endpoint = fmt.Sprintf("%s://%s/", u.Scheme, u.Host)
+	client.ResourceBase = fmt.Sprintf("%s%s/%s/", client.Endpoint, version, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) getEndpointType() golangsdk.Availability {
 	if c.EndpointType == "internal" || c.EndpointType == "internalURL" {
 		return golangsdk.AvailabilityInternal
@@ -827,6 +860,27 @@ func (c *Config) DmsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// DmsV2Client returns a client for DMS for Kafka v2 API, sharing the DMS v1 endpoint
+func (c *Config) DmsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.DmsV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = fmt.Sprintf("%sv2/%s/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
+// DmsKafkaV1Client returns a client for DMS for Kafka v1 API (e.g. topic access policies),
+// sharing the DMS v1 endpoint
+func (c *Config) DmsKafkaV1Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.DmsV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = fmt.Sprintf("%sv1/%s/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) MrsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewMapReduceV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -866,6 +920,15 @@ func (c *Config) CtsV1Client(projectName ProjectName) (*golangsdk.ServiceClient,
 	})
 }
 
+// CtsV3Client returns a client for CTS v3 API, sharing the CTS v1 endpoint
+func (c *Config) CtsV3Client(projectName ProjectName) (*golangsdk.ServiceClient, error) {
+	client, err := c.CtsV1Client(projectName)
+	if err != nil {
+		return nil, err
+	}
+	return withVersion(client, "v3")
+}
+
 func (c *Config) CssV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewCSSService(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -896,6 +959,16 @@ func (c *Config) DcsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// DcsV2Client returns a client for DCS v2 API, sharing the DCS v1 endpoint
+func (c *Config) DcsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.DcsV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = fmt.Sprintf("%sv2/%s/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) RdsTagV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewRdsTagV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -910,6 +983,16 @@ func (c *Config) WafV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// WafDedicatedV1Client returns a client for dedicated WAF API, sharing the WAF v1 endpoint
+func (c *Config) WafDedicatedV1Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.WafV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = fmt.Sprintf("%sv1/%s/premium-waf/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) RdsV3Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewRDSV3(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
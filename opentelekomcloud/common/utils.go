@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	ver "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -218,6 +219,22 @@ func StrSliceContains(haystack []string, needle string) bool {
 	return false
 }
 
+// TimeRangeMilliseconds returns query range set by `from`, `to` and `duration` in Unix milliseconds
+func TimeRangeMilliseconds(d *schema.ResourceData) (int64, int64) {
+	to := time.Now()
+	if v, ok := d.GetOk("to"); ok {
+		to, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	var from time.Time
+	if v, ok := d.GetOk("from"); ok {
+		from, _ = time.Parse(time.RFC3339, v.(string))
+	} else {
+		duration, _ := time.ParseDuration(d.Get("duration").(string))
+		from = to.Add(-duration)
+	}
+	return from.UnixNano() / int64(time.Millisecond), to.UnixNano() / int64(time.Millisecond)
+}
+
 func GetAllAvailableZones(d *schema.ResourceData) []string {
 	rawZones := d.Get("available_zones").([]interface{})
 	zones := make([]string, len(rawZones))
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var (
@@ -387,3 +388,16 @@ func ValidatePositiveInt(v interface{}, k string) (ws []string, errors []error)
 	errors = append(errors, fmt.Errorf("%q must be a positive integer", k))
 	return
 }
+
+// ValidateDuration checks that value is a positive duration, e.g. `24h`
+func ValidateDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration, e.g. `24h`: %s", k, err))
+		return
+	}
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be positive", k))
+	}
+	return
+}
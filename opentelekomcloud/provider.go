@@ -222,6 +222,8 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_cce_cluster_v3":                cce.DataSourceCCEClusterV3(),
 			"opentelekomcloud_cce_node_ids_v3":               cce.DataSourceCceNodeIdsV3(),
 			"opentelekomcloud_cce_node_v3":                   cce.DataSourceCceNodesV3(),
+			"opentelekomcloud_ces_metric_data":               ces.DataSourceMetricData(),
+			"opentelekomcloud_ces_metrics":                   ces.DataSourceMetrics(),
 			"opentelekomcloud_compute_availability_zones_v2": ecs.DataSourceComputeAvailabilityZonesV2(),
 			"opentelekomcloud_compute_bms_flavors_v2":        bms.DataSourceBMSFlavorV2(),
 			"opentelekomcloud_compute_bms_keypairs_v2":       bms.DataSourceBMSKeyPairV2(),
@@ -231,6 +233,7 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_csbs_backup_policy_v1":         csbs.DataSourceCSBSBackupPolicyV1(),
 			"opentelekomcloud_css_flavor_v1":                 css.DataSourceCSSFlavorV1(),
 			"opentelekomcloud_cts_tracker_v1":                cts.DataSourceCTSTrackerV1(),
+			"opentelekomcloud_cts_traces":                    cts.DataSourceCTSTraces(),
 			"opentelekomcloud_dcs_az_v1":                     dcs.DataSourceDcsAZV1(),
 			"opentelekomcloud_dcs_maintainwindow_v1":         dcs.DataSourceDcsMaintainWindowV1(),
 			"opentelekomcloud_dcs_product_v1":                dcs.DataSourceDcsProductV1(),
@@ -241,6 +244,7 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_dms_az_v1":                     dms.DataSourceDmsAZV1(),
 			"opentelekomcloud_dms_product_v1":                dms.DataSourceDmsProductV1(),
 			"opentelekomcloud_dms_maintainwindow_v1":         dms.DataSourceDmsMaintainWindowV1(),
+			"opentelekomcloud_dns_zone_file_v2":              dns.DataSourceDNSZoneFileV2(),
 			"opentelekomcloud_dns_zone_v2":                   dns.DataSourceDNSZoneV2(),
 			"opentelekomcloud_identity_auth_scope_v3":        iam.DataSourceIdentityAuthScopeV3(),
 			"opentelekomcloud_identity_credential_v3":        iam.DataSourceIdentityCredentialV3(),
@@ -250,11 +254,17 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_identity_user_v3":              iam.DataSourceIdentityUserV3(),
 			"opentelekomcloud_images_image_v2":               ims.DataSourceImagesImageV2(),
 			"opentelekomcloud_kms_key_v1":                    kms.DataSourceKmsKeyV1(),
+			"opentelekomcloud_kms_keys_v1":                   kms.DataSourceKmsKeysV1(),
 			"opentelekomcloud_kms_data_key_v1":               kms.DataSourceKmsDataKeyV1(),
+			"opentelekomcloud_kms_ciphertext_v1":             kms.DataSourceKmsCiphertextV1(),
+			"opentelekomcloud_kms_secrets_v1":                kms.DataSourceKmsSecretsV1(),
+			"opentelekomcloud_logtank_group_v2":              lts.DataSourceLTSGroupV2(),
+			"opentelekomcloud_logtank_topic_v2":              lts.DataSourceLTSTopicV2(),
 			"opentelekomcloud_networking_network_v2":         vpc.DataSourceNetworkingNetworkV2(),
 			"opentelekomcloud_networking_port_v2":            vpc.DataSourceNetworkingPortV2(),
 			"opentelekomcloud_networking_secgroup_v2":        vpc.DataSourceNetworkingSecGroupV2(),
 			"opentelekomcloud_obs_bucket_object":             obs.DataSourceObsBucketObject(),
+			"opentelekomcloud_obs_bucket_objects":            obs.DataSourceObsBucketObjects(),
 			"opentelekomcloud_rds_flavors_v1":                rds.DataSourceRdsFlavorV1(),
 			"opentelekomcloud_rds_flavors_v3":                rds.DataSourceRdsFlavorV3(),
 			"opentelekomcloud_rds_versions_v3":               rds.DataSourceRdsVersionsV3(),
@@ -290,6 +300,8 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_cce_node_v3":                        cce.ResourceCCENodeV3(),
 			"opentelekomcloud_cce_node_pool_v3":                   cce.ResourceCCENodePoolV3(),
 			"opentelekomcloud_ces_alarmrule":                      ces.ResourceAlarmRule(),
+			"opentelekomcloud_ces_alarmrule_v2":                   ces.ResourceAlarmRuleV2(),
+			"opentelekomcloud_ces_alarm_template":                 ces.ResourceAlarmTemplate(),
 			"opentelekomcloud_compute_bms_server_v2":              bms.ResourceComputeBMSInstanceV2(),
 			"opentelekomcloud_compute_bms_tags_v2":                bms.ResourceBMSTagsV2(),
 			"opentelekomcloud_compute_secgroup_v2":                ecs.ResourceComputeSecGroupV2(),
@@ -302,15 +314,29 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_csbs_backup_v1":                     csbs.ResourceCSBSBackupV1(),
 			"opentelekomcloud_csbs_backup_policy_v1":              csbs.ResourceCSBSBackupPolicyV1(),
 			"opentelekomcloud_cts_tracker_v1":                     cts.ResourceCTSTrackerV1(),
+			"opentelekomcloud_cts_data_tracker_v3":                cts.ResourceCTSDataTrackerV3(),
+			"opentelekomcloud_cts_event_notification_v3":          cts.ResourceCTSEventNotificationV3(),
 			"opentelekomcloud_css_cluster_v1":                     css.ResourceCssClusterV1(),
+			"opentelekomcloud_css_snapshot_v1":                    css.ResourceCssSnapshotV1(),
+			"opentelekomcloud_css_snapshot_configuration_v1":      css.ResourceCssSnapshotConfigurationV1(),
+			"opentelekomcloud_css_snapshot_restore_v1":            css.ResourceCssSnapshotRestoreV1(),
+			"opentelekomcloud_dcs_backup_v1":                      dcs.ResourceDcsBackupV1(),
 			"opentelekomcloud_dcs_instance_v1":                    dcs.ResourceDcsInstanceV1(),
+			"opentelekomcloud_dcs_restore_v1":                     dcs.ResourceDcsRestoreV1(),
+			"opentelekomcloud_dcs_whitelist_v1":                   dcs.ResourceDcsWhitelistV1(),
+			"opentelekomcloud_dds_backup_v3":                      dds.ResourceDdsBackupV3(),
 			"opentelekomcloud_dds_instance_v3":                    dds.ResourceDdsInstanceV3(),
+			"opentelekomcloud_dds_parameter_template_v3":          dds.ResourceDdsParameterTemplateV3(),
 			"opentelekomcloud_deh_host_v1":                        deh.ResourceDeHHostV1(),
 			"opentelekomcloud_dns_ptrrecord_v2":                   dns.ResourceDNSPtrRecordV2(),
 			"opentelekomcloud_dns_recordset_v2":                   dns.ResourceDNSRecordSetV2(),
+			"opentelekomcloud_dns_zone_records_v2":                dns.ResourceDNSZoneRecordsV2(),
 			"opentelekomcloud_dns_zone_v2":                        dns.ResourceDNSZoneV2(),
 			"opentelekomcloud_dms_group_v1":                       dms.ResourceDmsGroupsV1(),
 			"opentelekomcloud_dms_instance_v1":                    dms.ResourceDmsInstancesV1(),
+			"opentelekomcloud_dms_kafka_instance_v2":              dms.ResourceDmsKafkaInstanceV2(),
+			"opentelekomcloud_dms_kafka_topic_v2":                 dms.ResourceDmsKafkaTopicV2(),
+			"opentelekomcloud_dms_kafka_user_v2":                  dms.ResourceDmsKafkaUserV2(),
 			"opentelekomcloud_dms_queue_v1":                       dms.ResourceDmsQueuesV1(),
 			"opentelekomcloud_ecs_instance_v1":                    ecs.ResourceEcsInstanceV1(),
 			"opentelekomcloud_elb_backend":                        elb.ResourceBackend(),
@@ -335,6 +361,8 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_ims_data_image_v2":                  ims.ResourceImsDataImageV2(),
 			"opentelekomcloud_ims_image_v2":                       ims.ResourceImsImageV2(),
 			"opentelekomcloud_kms_key_v1":                         kms.ResourceKmsKeyV1(),
+			"opentelekomcloud_kms_grant_v1":                       kms.ResourceKmsGrantV1(),
+			"opentelekomcloud_kms_key_material_v1":                kms.ResourceKmsKeyMaterialV1(),
 			"opentelekomcloud_lb_certificate_v2":                  elb.ResourceCertificateV2(),
 			"opentelekomcloud_lb_l7policy_v2":                     elb.ResourceL7PolicyV2(),
 			"opentelekomcloud_lb_l7rule_v2":                       elb.ResourceL7RuleV2(),
@@ -346,6 +374,10 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_lb_whitelist_v2":                    elb.ResourceWhitelistV2(),
 			"opentelekomcloud_logtank_group_v2":                   lts.ResourceLTSGroupV2(),
 			"opentelekomcloud_logtank_topic_v2":                   lts.ResourceLTSTopicV2(),
+			"opentelekomcloud_logtank_structuring_config_v2":      lts.ResourceLTSStructuringConfigurationV2(),
+			"opentelekomcloud_logtank_transfer_v2":                lts.ResourceLTSTransferV2(),
+			"opentelekomcloud_logtank_access_config_v2":           lts.ResourceLTSAccessConfigV2(),
+			"opentelekomcloud_logtank_host_group_v2":              lts.ResourceLTSHostGroupV2(),
 			"opentelekomcloud_mrs_cluster_v1":                     mrs.ResourceMRSClusterV1(),
 			"opentelekomcloud_mrs_job_v1":                         mrs.ResourceMRSJobV1(),
 			"opentelekomcloud_nat_gateway_v2":                     nat.ResourceNatGatewayV2(),
@@ -364,6 +396,7 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_networking_vip_v2":                  vpc.ResourceNetworkingVIPV2(),
 			"opentelekomcloud_networking_vip_associate_v2":        vpc.ResourceNetworkingVIPAssociateV2(),
 			"opentelekomcloud_obs_bucket":                         obs.ResourceObsBucket(),
+			"opentelekomcloud_obs_bucket_directory":               obs.ResourceObsBucketDirectory(),
 			"opentelekomcloud_obs_bucket_object":                  obs.ResourceObsBucketObject(),
 			"opentelekomcloud_obs_bucket_policy":                  obs.ResourceObsBucketPolicy(),
 			"opentelekomcloud_rds_instance_v1":                    rds.ResourceRdsInstance(),
@@ -380,6 +413,8 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_sfs_turbo_share_v1":                 sfs.ResourceSFSTurboShareV1(),
 			"opentelekomcloud_smn_topic_v2":                       smn.ResourceTopic(),
 			"opentelekomcloud_smn_subscription_v2":                smn.ResourceSubscription(),
+			"opentelekomcloud_smn_message_template_v2":            smn.ResourceMessageTemplate(),
+			"opentelekomcloud_smn_topic_attribute_v2":             smn.ResourceTopicAttribute(),
 			"opentelekomcloud_vpc_eip_v1":                         vpc.ResourceVpcEIPV1(),
 			"opentelekomcloud_vpc_v1":                             vpc.ResourceVirtualPrivateCloudV1(),
 			"opentelekomcloud_vpc_peering_connection_v2":          vpc.ResourceVpcPeeringConnectionV2(),
@@ -406,6 +441,11 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_waf_ccattackprotection_rule_v1":     waf.ResourceWafCcAttackProtectionRuleV1(),
 			"opentelekomcloud_waf_preciseprotection_rule_v1":      waf.ResourceWafPreciseProtectionRuleV1(),
 			"opentelekomcloud_waf_webtamperprotection_rule_v1":    waf.ResourceWafWebTamperProtectionRuleV1(),
+			"opentelekomcloud_waf_geolocation_rule_v1":            waf.ResourceWafGeolocationRuleV1(),
+			"opentelekomcloud_waf_reference_table_v1":             waf.ResourceWafReferenceTableV1(),
+			"opentelekomcloud_waf_rule_set_v1":                    waf.ResourceWafRuleSetV1(),
+			"opentelekomcloud_waf_dedicated_instance":             waf.ResourceWafDedicatedInstance(),
+			"opentelekomcloud_waf_dedicated_domain":               waf.ResourceWafDedicatedDomain(),
 		},
 	}
 
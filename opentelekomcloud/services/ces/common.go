@@ -0,0 +1,232 @@
+package ces
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metricdata"
+)
+
+// updateAlarmRule changes v1 alarm rule, the body contains only changed fields
+func updateAlarmRule(client *golangsdk.ServiceClient, id string, body map[string]interface{}) error {
+	_, err := client.Put(client.ServiceURL("alarms", id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+type alarmDimension struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type alarmPolicy struct {
+	MetricName         string  `json:"metric_name"`
+	Period             int     `json:"period"`
+	Filter             string  `json:"filter"`
+	ComparisonOperator string  `json:"comparison_operator"`
+	Value              float64 `json:"value"`
+	Unit               string  `json:"unit,omitempty"`
+	Count              int     `json:"count"`
+	SuppressDuration   int     `json:"suppress_duration"`
+	Level              int     `json:"level"`
+}
+
+type alarmNotification struct {
+	Type             string   `json:"type"`
+	NotificationList []string `json:"notification_list"`
+}
+
+type alarmNotificationOpts struct {
+	NotificationEnabled   bool                `json:"notification_enabled"`
+	AlarmNotifications    []alarmNotification `json:"alarm_notifications,omitempty"`
+	OkNotifications       []alarmNotification `json:"ok_notifications,omitempty"`
+	NotificationBeginTime string              `json:"notification_begin_time,omitempty"`
+	NotificationEndTime   string              `json:"notification_end_time,omitempty"`
+}
+
+type alarmRuleV2CreateOpts struct {
+	Name            string             `json:"name"`
+	Description     string             `json:"description,omitempty"`
+	Namespace       string             `json:"namespace"`
+	Type            string             `json:"type"`
+	ResourceGroupID string             `json:"resource_group_id,omitempty"`
+	Resources       [][]alarmDimension `json:"resources"`
+	Policies        []alarmPolicy      `json:"policies,omitempty"`
+	AlarmTemplateID string             `json:"alarm_template_id,omitempty"`
+	Enabled         bool               `json:"enabled"`
+	alarmNotificationOpts
+}
+
+type alarmRuleV2 struct {
+	AlarmID         string             `json:"alarm_id"`
+	Name            string             `json:"name"`
+	Description     string             `json:"description"`
+	Namespace       string             `json:"namespace"`
+	Type            string             `json:"type"`
+	ResourceGroupID string             `json:"resource_group_id"`
+	Resources       [][]alarmDimension `json:"resources"`
+	Policies        []alarmPolicy      `json:"policies"`
+	AlarmTemplateID string             `json:"alarm_template_id"`
+	Enabled         bool               `json:"enabled"`
+	alarmNotificationOpts
+}
+
+func createAlarmRuleV2(client *golangsdk.ServiceClient, opts alarmRuleV2CreateOpts) (string, error) {
+	var result struct {
+		AlarmID string `json:"alarm_id"`
+	}
+	_, err := client.Post(client.ServiceURL("alarms"), opts, &result, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return result.AlarmID, err
+}
+
+func getAlarmRuleV2(client *golangsdk.ServiceClient, id string) (*alarmRuleV2, error) {
+	var result struct {
+		Alarms []alarmRuleV2 `json:"alarms"`
+	}
+	_, err := client.Get(client.ServiceURL("alarms")+"?alarm_id="+id, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, alarm := range result.Alarms {
+		if alarm.AlarmID == id {
+			return &alarm, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func updateAlarmPoliciesV2(client *golangsdk.ServiceClient, id string, policies []alarmPolicy) error {
+	body := map[string]interface{}{
+		"policies": policies,
+	}
+	_, err := client.Put(client.ServiceURL("alarms", id, "policies"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// updateAlarmRuleV2 changes name and description of the alarm rule
+func updateAlarmRuleV2(client *golangsdk.ServiceClient, id string, body map[string]interface{}) error {
+	_, err := client.Put(client.ServiceURL("alarms", id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func updateAlarmNotificationsV2(client *golangsdk.ServiceClient, id string, opts alarmNotificationOpts) error {
+	_, err := client.Put(client.ServiceURL("alarms", id, "notifications"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// updateAlarmResourcesV2 adds or removes monitored resources, action is `batch-create` or `batch-delete`
+func updateAlarmResourcesV2(client *golangsdk.ServiceClient, id, action string, resources [][]alarmDimension) error {
+	body := map[string]interface{}{
+		"resources": resources,
+	}
+	_, err := client.Post(client.ServiceURL("alarms", id, "resources", action), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func setAlarmEnabledV2(client *golangsdk.ServiceClient, id string, enabled bool) error {
+	body := map[string]interface{}{
+		"alarm_ids":     []string{id},
+		"alarm_enabled": enabled,
+	}
+	_, err := client.Post(client.ServiceURL("alarms", "action"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func deleteAlarmRuleV2(client *golangsdk.ServiceClient, id string) error {
+	body := map[string]interface{}{
+		"alarm_ids": []string{id},
+	}
+	_, err := client.Post(client.ServiceURL("alarms", "batch-delete"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+type alarmTemplatePolicy struct {
+	Namespace          string  `json:"namespace"`
+	DimensionName      string  `json:"dimension_name"`
+	MetricName         string  `json:"metric_name"`
+	Period             int     `json:"period"`
+	Filter             string  `json:"filter"`
+	ComparisonOperator string  `json:"comparison_operator"`
+	Value              float64 `json:"value"`
+	Unit               string  `json:"unit,omitempty"`
+	Count              int     `json:"count"`
+	AlarmLevel         int     `json:"alarm_level"`
+	SuppressDuration   int     `json:"suppress_duration"`
+}
+
+type alarmTemplateOpts struct {
+	Name        string                `json:"template_name"`
+	Description string                `json:"template_description"`
+	Policies    []alarmTemplatePolicy `json:"policies"`
+}
+
+type alarmTemplate struct {
+	ID          string                `json:"template_id"`
+	Name        string                `json:"template_name"`
+	Description string                `json:"template_description"`
+	Type        string                `json:"template_type"`
+	CreateTime  string                `json:"create_time"`
+	Policies    []alarmTemplatePolicy `json:"policies"`
+}
+
+func createAlarmTemplate(client *golangsdk.ServiceClient, opts alarmTemplateOpts) (string, error) {
+	var result struct {
+		ID string `json:"template_id"`
+	}
+	_, err := client.Post(client.ServiceURL("alarm-templates"), opts, &result, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return result.ID, err
+}
+
+func getAlarmTemplate(client *golangsdk.ServiceClient, id string) (*alarmTemplate, error) {
+	template := new(alarmTemplate)
+	_, err := client.Get(client.ServiceURL("alarm-templates", id), template, nil)
+	return template, err
+}
+
+func updateAlarmTemplate(client *golangsdk.ServiceClient, id string, opts alarmTemplateOpts) error {
+	_, err := client.Put(client.ServiceURL("alarm-templates", id), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func deleteAlarmTemplate(client *golangsdk.ServiceClient, id string) error {
+	body := map[string]interface{}{
+		"template_ids": []string{id},
+	}
+	_, err := client.Post(client.ServiceURL("alarm-templates", "batch-delete"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// metricDatapoint contains the metric value under the key equal to the query filter
+type metricDatapoint map[string]interface{}
+
+// getMetricData queries metric data, metricdata.Get extracts only average values
+func getMetricData(client *golangsdk.ServiceClient, opts metricdata.GetOpts) ([]metricDatapoint, error) {
+	query, err := golangsdk.BuildQueryString(&opts)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Datapoints []metricDatapoint `json:"datapoints"`
+	}
+	_, err = client.Get(client.ServiceURL("metric-data")+query.String(), &result, nil)
+	return result.Datapoints, err
+}
@@ -0,0 +1,213 @@
+package ces
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metricdata"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceMetricData() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMetricDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dimensions": metricDimensionsSchema(true),
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 300, 1200, 3600, 14400, 86400}),
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"average", "max", "min", "sum", "variance",
+				}, false),
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+				ExactlyOneOf: []string{"from", "duration"},
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: common.ValidateDuration,
+				ExactlyOneOf: []string{"from", "duration"},
+			},
+			"datapoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datapoints_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"max": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"average": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"sum": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func metricDimensionsSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 3,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// expandMetricDimensions returns dimensions in `name,value` format used in queries
+func expandMetricDimensions(raw []interface{}) [3]string {
+	var dimensions [3]string
+	for i, v := range raw {
+		dimension := v.(map[string]interface{})
+		dimensions[i] = fmt.Sprintf("%s,%s", dimension["name"], dimension["value"])
+	}
+	return dimensions
+}
+
+func dataSourceMetricDataRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	from, to := common.TimeRangeMilliseconds(d)
+	if from >= to {
+		return fmt.Errorf("start of the time range must be before its end")
+	}
+	dimensions := expandMetricDimensions(d.Get("dimensions").([]interface{}))
+	filter := d.Get("filter").(string)
+	opts := metricdata.GetOpts{
+		Namespace:  d.Get("namespace").(string),
+		MetricName: d.Get("metric_name").(string),
+		Dim0:       dimensions[0],
+		Dim1:       dimensions[1],
+		Dim2:       dimensions[2],
+		Filter:     filter,
+		Period:     strconv.Itoa(d.Get("period").(int)),
+		From:       strconv.FormatInt(from, 10),
+		To:         strconv.FormatInt(to, 10),
+	}
+	log.Printf("[DEBUG] Querying metric data: %#v", opts)
+
+	points, err := getMetricData(client, opts)
+	if err != nil {
+		return fmt.Errorf("Error querying metric data: %s", err)
+	}
+
+	var unit string
+	var sum float64
+	min, max := math.Inf(1), math.Inf(-1)
+	datapoints := make([]map[string]interface{}, 0, len(points))
+	for _, point := range points {
+		value, ok := point[filter].(float64)
+		if !ok {
+			continue
+		}
+		timestamp, _ := point["timestamp"].(float64)
+		if u, ok := point["unit"].(string); ok && unit == "" {
+			unit = u
+		}
+		datapoints = append(datapoints, map[string]interface{}{
+			"timestamp": time.Unix(0, int64(timestamp)*int64(time.Millisecond)).UTC().Format(time.RFC3339),
+			"value":     value,
+		})
+		sum += value
+		min = math.Min(min, value)
+		max = math.Max(max, value)
+	}
+
+	var average float64
+	if len(datapoints) > 0 {
+		average = sum / float64(len(datapoints))
+	} else {
+		min, max = 0, 0
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join([]string{
+		opts.Namespace, opts.MetricName, opts.Dim0, opts.Dim1, opts.Dim2, opts.Filter, opts.Period, opts.From, opts.To,
+	}, "|"))))
+
+	mErr := multierror.Append(nil,
+		d.Set("datapoints", datapoints),
+		d.Set("unit", unit),
+		d.Set("datapoints_count", len(datapoints)),
+		d.Set("min", min),
+		d.Set("max", max),
+		d.Set("average", average),
+		d.Set("sum", sum),
+	)
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,126 @@
+package ces
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metrics"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceMetrics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMetricsRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dimensions": metricDimensionsSchema(false),
+			"metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dimensions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetricsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	// the limit is required to get next pages
+	limit := 1000
+	dimensions := expandMetricDimensions(d.Get("dimensions").([]interface{}))
+	opts := metrics.ListOpts{
+		Namespace:  d.Get("namespace").(string),
+		MetricName: d.Get("metric_name").(string),
+		Dim0:       dimensions[0],
+		Dim1:       dimensions[1],
+		Dim2:       dimensions[2],
+		Limit:      &limit,
+	}
+	log.Printf("[DEBUG] Listing metrics: %#v", opts)
+
+	var result []map[string]interface{}
+	err = metrics.List(client, opts).EachPage(func(page pagination.Page) (bool, error) {
+		list, err := metrics.ExtractMetrics(page)
+		if err != nil {
+			return false, err
+		}
+		for _, metric := range list.Metrics {
+			metricDimensions := make([]map[string]interface{}, len(metric.Dimensions))
+			for i, dimension := range metric.Dimensions {
+				metricDimensions[i] = map[string]interface{}{
+					"name":  dimension.Name,
+					"value": dimension.Value,
+				}
+			}
+			result = append(result, map[string]interface{}{
+				"namespace":   metric.Namespace,
+				"metric_name": metric.MetricName,
+				"unit":        metric.Unit,
+				"dimensions":  metricDimensions,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing metrics: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join([]string{
+		opts.Namespace, opts.MetricName, opts.Dim0, opts.Dim1, opts.Dim2,
+	}, "|"))))
+
+	if err := d.Set("metrics", result); err != nil {
+		return fmt.Errorf("Error setting metrics: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+package ces
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const nameCESAT = "CES-AlarmTemplate"
+
+func ResourceAlarmTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlarmTemplateCreate,
+		Read:   resourceAlarmTemplateRead,
+		Update: resourceAlarmTemplateUpdate,
+		Delete: resourceAlarmTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"dimension_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{1, 300, 1200, 3600, 14400, 86400}),
+						},
+						"filter": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"max", "min", "average", "sum", "variance",
+							}, false),
+						},
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								">", "=", "<", ">=", "<=", "!=",
+							}, false),
+						},
+						"value": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"suppress_duration": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+							ValidateFunc: validation.IntInSlice([]int{
+								0, 300, 600, 900, 1800, 3600, 10800, 21600, 43200, 86400,
+							}),
+						},
+						"alarm_level": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntBetween(1, 4),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandAlarmTemplatePolicies(raw []interface{}) []alarmTemplatePolicy {
+	policies := make([]alarmTemplatePolicy, len(raw))
+	for i, v := range raw {
+		policy := v.(map[string]interface{})
+		policies[i] = alarmTemplatePolicy{
+			Namespace:          policy["namespace"].(string),
+			DimensionName:      policy["dimension_name"].(string),
+			MetricName:         policy["metric_name"].(string),
+			Period:             policy["period"].(int),
+			Filter:             policy["filter"].(string),
+			ComparisonOperator: policy["comparison_operator"].(string),
+			Value:              policy["value"].(float64),
+			Unit:               policy["unit"].(string),
+			Count:              policy["count"].(int),
+			AlarmLevel:         policy["alarm_level"].(int),
+			SuppressDuration:   policy["suppress_duration"].(int),
+		}
+	}
+	return policies
+}
+
+func flattenAlarmTemplatePolicies(policies []alarmTemplatePolicy) []interface{} {
+	result := make([]interface{}, len(policies))
+	for i, policy := range policies {
+		result[i] = map[string]interface{}{
+			"namespace":           policy.Namespace,
+			"dimension_name":      policy.DimensionName,
+			"metric_name":         policy.MetricName,
+			"period":              policy.Period,
+			"filter":              policy.Filter,
+			"comparison_operator": policy.ComparisonOperator,
+			"value":               policy.Value,
+			"unit":                policy.Unit,
+			"count":               policy.Count,
+			"alarm_level":         policy.AlarmLevel,
+			"suppress_duration":   policy.SuppressDuration,
+		}
+	}
+	return result
+}
+
+func getAlarmTemplateOpts(d *schema.ResourceData) alarmTemplateOpts {
+	return alarmTemplateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Policies:    expandAlarmTemplatePolicies(d.Get("policies").([]interface{})),
+	}
+}
+
+func resourceAlarmTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	createOpts := getAlarmTemplateOpts(d)
+	log.Printf("[DEBUG] Create %s Options: %#v", nameCESAT, createOpts)
+
+	id, err := createAlarmTemplate(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating %s: %s", nameCESAT, err)
+	}
+	d.SetId(id)
+
+	return resourceAlarmTemplateRead(d, meta)
+}
+
+func resourceAlarmTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	template, err := getAlarmTemplate(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "alarm template")
+	}
+	log.Printf("[DEBUG] Retrieved %s %s: %#v", nameCESAT, d.Id(), template)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", template.Name),
+		d.Set("description", template.Description),
+		d.Set("policies", flattenAlarmTemplatePolicies(template.Policies)),
+		d.Set("type", template.Type),
+		d.Set("create_time", template.CreateTime),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceAlarmTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	updateOpts := getAlarmTemplateOpts(d)
+	log.Printf("[DEBUG] Updating %s %s with options: %#v", nameCESAT, d.Id(), updateOpts)
+	if err := updateAlarmTemplate(client, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating %s %s: %s", nameCESAT, d.Id(), err)
+	}
+
+	return resourceAlarmTemplateRead(d, meta)
+}
+
+func resourceAlarmTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting %s %s", nameCESAT, d.Id())
+	if err := deleteAlarmTemplate(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "alarm template")
+	}
+
+	d.SetId("")
+	return nil
+}
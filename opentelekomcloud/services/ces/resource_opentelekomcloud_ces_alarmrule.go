@@ -34,7 +34,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"alarm_name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					value := v.(string)
 					vv := regexp.MustCompile("^[a-zA-Z0-9_]{1,128}$")
@@ -48,7 +47,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"alarm_description": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					value := v.(string)
 					if len(value) > 256 {
@@ -61,7 +59,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"alarm_level": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ForceNew:     true,
 				Default:      2,
 				ValidateFunc: validation.IntBetween(1, 4),
 			},
@@ -69,7 +66,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"metric": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -132,7 +128,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"condition": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -226,7 +221,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"alarm_actions": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
@@ -257,7 +251,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"insufficientdata_actions": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
@@ -288,7 +281,6 @@ func ResourceAlarmRule() *schema.Resource {
 			"ok_actions": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
@@ -326,7 +318,6 @@ func ResourceAlarmRule() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
-				ForceNew: true,
 			},
 
 			"update_time": {
@@ -367,6 +358,18 @@ func getMetricOpts(d *schema.ResourceData) (alarmrule.MetricOpts, error) {
 	}, nil
 }
 
+func getConditionOpts(d *schema.ResourceData) alarmrule.ConditionOpts {
+	co := d.Get("condition").([]interface{})[0].(map[string]interface{})
+	return alarmrule.ConditionOpts{
+		Period:             co["period"].(int),
+		Filter:             co["filter"].(string),
+		ComparisonOperator: co["comparison_operator"].(string),
+		Value:              co["value"].(int),
+		Unit:               co["unit"].(string),
+		Count:              co["count"].(int),
+	}
+}
+
 func getAlarmAction(d *schema.ResourceData, name string) []alarmrule.ActionOpts {
 	aos := d.Get(name).([]interface{})
 	if len(aos) == 0 {
@@ -401,21 +404,12 @@ func resourceAlarmRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	cos := d.Get("condition").([]interface{})
-	co := cos[0].(map[string]interface{})
 	createOpts := alarmrule.CreateOpts{
-		AlarmName:        d.Get("alarm_name").(string),
-		AlarmDescription: d.Get("alarm_description").(string),
-		AlarmLevel:       d.Get("alarm_level").(int),
-		Metric:           metric,
-		Condition: alarmrule.ConditionOpts{
-			Period:             co["period"].(int),
-			Filter:             co["filter"].(string),
-			ComparisonOperator: co["comparison_operator"].(string),
-			Value:              co["value"].(int),
-			Unit:               co["unit"].(string),
-			Count:              co["count"].(int),
-		},
+		AlarmName:               d.Get("alarm_name").(string),
+		AlarmDescription:        d.Get("alarm_description").(string),
+		AlarmLevel:              d.Get("alarm_level").(int),
+		Metric:                  metric,
+		Condition:               getConditionOpts(d),
 		AlarmActions:            getAlarmAction(d, "alarm_actions"),
 		InsufficientdataActions: getAlarmAction(d, "insufficientdata_actions"),
 		OkActions:               getAlarmAction(d, "ok_actions"),
@@ -475,24 +469,67 @@ func resourceAlarmRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	arId := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
 
-	if !d.HasChange("alarm_enabled") {
-		log.Printf("[WARN] %s Nothing will be updated", nameCESAR)
-		return nil
+	updateOpts := make(map[string]interface{})
+	if d.HasChange("alarm_name") {
+		updateOpts["alarm_name"] = d.Get("alarm_name").(string)
+	}
+	if d.HasChange("alarm_description") {
+		updateOpts["alarm_description"] = d.Get("alarm_description").(string)
+	}
+	if d.HasChange("alarm_level") {
+		updateOpts["alarm_level"] = d.Get("alarm_level").(int)
+	}
+	if d.HasChange("metric") {
+		metric, err := getMetricOpts(d)
+		if err != nil {
+			return err
+		}
+		updateOpts["metric"] = metric
+	}
+	if d.HasChange("condition") {
+		updateOpts["condition"] = getConditionOpts(d)
+	}
+	for _, name := range []string{"alarm_actions", "insufficientdata_actions", "ok_actions"} {
+		if d.HasChange(name) {
+			actions := getAlarmAction(d, name)
+			if actions == nil {
+				actions = []alarmrule.ActionOpts{}
+			}
+			updateOpts[name] = actions
+		}
+	}
+	if d.HasChange("alarm_action_enabled") {
+		updateOpts["alarm_action_enabled"] = d.Get("alarm_action_enabled").(bool)
 	}
-	updateOpts := alarmrule.UpdateOpts{AlarmEnabled: d.Get("alarm_enabled").(bool)}
-	log.Printf("[DEBUG] Updating %s %s with options: %#v", nameCESAR, arId, updateOpts)
 
-	timeout := d.Timeout(schema.TimeoutUpdate)
-	err = resource.Retry(timeout, func() *resource.RetryError {
-		err := alarmrule.Update(client, arId, updateOpts).ExtractErr()
+	if len(updateOpts) > 0 {
+		log.Printf("[DEBUG] Updating %s %s with options: %#v", nameCESAR, arId, updateOpts)
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			if err := updateAlarmRule(client, arId, updateOpts); err != nil {
+				return common.CheckForRetryableError(err)
+			}
+			return nil
+		})
 		if err != nil {
-			return common.CheckForRetryableError(err)
+			return fmt.Errorf("Error updating %s %s: %s", nameCESAR, arId, err)
+		}
+	}
+
+	if d.HasChange("alarm_enabled") {
+		enabledOpts := alarmrule.UpdateOpts{AlarmEnabled: d.Get("alarm_enabled").(bool)}
+		log.Printf("[DEBUG] Updating %s %s with options: %#v", nameCESAR, arId, enabledOpts)
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err := alarmrule.Update(client, arId, enabledOpts).ExtractErr()
+			if err != nil {
+				return common.CheckForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating %s %s: %s", nameCESAR, arId, err)
 		}
-		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("Error updating %s %s: %s", nameCESAR, arId, err)
 	}
 
 	return resourceAlarmRuleRead(d, meta)
@@ -0,0 +1,489 @@
+package ces
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const nameCESARv2 = "CES-AlarmRule-v2"
+
+func ResourceAlarmRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlarmRuleV2Create,
+		Read:   resourceAlarmRuleV2Read,
+		Update: resourceAlarmRuleV2Update,
+		Delete: resourceAlarmRuleV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "MULTI_INSTANCE",
+				ValidateFunc: validation.StringInSlice([]string{
+					"MULTI_INSTANCE", "ALL_INSTANCE", "RESOURCE_GROUP", "EVENT.SYS", "EVENT.CUSTOM",
+				}, false),
+			},
+			"resource_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"resources"},
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dimensions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 4,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"policies": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"policies", "alarm_template_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{1, 300, 1200, 3600, 14400, 86400}),
+						},
+						"filter": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"max", "min", "average", "sum", "variance",
+							}, false),
+						},
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								">", "=", "<", ">=", "<=", "!=",
+							}, false),
+						},
+						"value": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"suppress_duration": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+							ValidateFunc: validation.IntInSlice([]int{
+								0, 300, 600, 900, 1800, 3600, 10800, 21600, 43200, 86400,
+							}),
+						},
+						"alarm_level": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntBetween(1, 4),
+						},
+					},
+				},
+			},
+			"alarm_template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"policies", "alarm_template_id"},
+			},
+			"alarm_notifications": alarmNotificationsSchema(),
+			"ok_notifications":    alarmNotificationsSchema(),
+			"notification_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"notification_begin_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"notification_end_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func alarmNotificationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"notification", "autoscaling",
+					}, false),
+				},
+				"notification_list": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 5,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandAlarmResources(raw []interface{}) [][]alarmDimension {
+	resources := make([][]alarmDimension, 0, len(raw))
+	for _, r := range raw {
+		rawDimensions := r.(map[string]interface{})["dimensions"].([]interface{})
+		dimensions := make([]alarmDimension, len(rawDimensions))
+		for i, v := range rawDimensions {
+			dimension := v.(map[string]interface{})
+			dimensions[i] = alarmDimension{
+				Name:  dimension["name"].(string),
+				Value: dimension["value"].(string),
+			}
+		}
+		resources = append(resources, dimensions)
+	}
+	return resources
+}
+
+func flattenAlarmResources(resources [][]alarmDimension) []interface{} {
+	result := make([]interface{}, len(resources))
+	for i, dimensions := range resources {
+		rawDimensions := make([]interface{}, len(dimensions))
+		for j, dimension := range dimensions {
+			rawDimensions[j] = map[string]interface{}{
+				"name":  dimension.Name,
+				"value": dimension.Value,
+			}
+		}
+		result[i] = map[string]interface{}{
+			"dimensions": rawDimensions,
+		}
+	}
+	return result
+}
+
+func expandAlarmPolicies(raw []interface{}) []alarmPolicy {
+	policies := make([]alarmPolicy, len(raw))
+	for i, v := range raw {
+		policy := v.(map[string]interface{})
+		policies[i] = alarmPolicy{
+			MetricName:         policy["metric_name"].(string),
+			Period:             policy["period"].(int),
+			Filter:             policy["filter"].(string),
+			ComparisonOperator: policy["comparison_operator"].(string),
+			Value:              policy["value"].(float64),
+			Unit:               policy["unit"].(string),
+			Count:              policy["count"].(int),
+			SuppressDuration:   policy["suppress_duration"].(int),
+			Level:              policy["alarm_level"].(int),
+		}
+	}
+	return policies
+}
+
+func flattenAlarmPolicies(policies []alarmPolicy) []interface{} {
+	result := make([]interface{}, len(policies))
+	for i, policy := range policies {
+		result[i] = map[string]interface{}{
+			"metric_name":         policy.MetricName,
+			"period":              policy.Period,
+			"filter":              policy.Filter,
+			"comparison_operator": policy.ComparisonOperator,
+			"value":               policy.Value,
+			"unit":                policy.Unit,
+			"count":               policy.Count,
+			"suppress_duration":   policy.SuppressDuration,
+			"alarm_level":         policy.Level,
+		}
+	}
+	return result
+}
+
+func expandAlarmNotifications(raw []interface{}) []alarmNotification {
+	notifications := make([]alarmNotification, len(raw))
+	for i, v := range raw {
+		notification := v.(map[string]interface{})
+		notifications[i] = alarmNotification{
+			Type:             notification["type"].(string),
+			NotificationList: common.ExpandToStringSlice(notification["notification_list"].([]interface{})),
+		}
+	}
+	return notifications
+}
+
+func flattenAlarmNotifications(notifications []alarmNotification) []interface{} {
+	result := make([]interface{}, len(notifications))
+	for i, notification := range notifications {
+		result[i] = map[string]interface{}{
+			"type":              notification.Type,
+			"notification_list": notification.NotificationList,
+		}
+	}
+	return result
+}
+
+func getAlarmNotificationOpts(d *schema.ResourceData) alarmNotificationOpts {
+	return alarmNotificationOpts{
+		NotificationEnabled:   d.Get("notification_enabled").(bool),
+		AlarmNotifications:    expandAlarmNotifications(d.Get("alarm_notifications").([]interface{})),
+		OkNotifications:       expandAlarmNotifications(d.Get("ok_notifications").([]interface{})),
+		NotificationBeginTime: d.Get("notification_begin_time").(string),
+		NotificationEndTime:   d.Get("notification_end_time").(string),
+	}
+}
+
+func resourceAlarmRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	createOpts := alarmRuleV2CreateOpts{
+		Name:                  d.Get("name").(string),
+		Description:           d.Get("description").(string),
+		Namespace:             d.Get("namespace").(string),
+		Type:                  d.Get("type").(string),
+		ResourceGroupID:       d.Get("resource_group_id").(string),
+		Resources:             expandAlarmResources(d.Get("resources").(*schema.Set).List()),
+		Policies:              expandAlarmPolicies(d.Get("policies").([]interface{})),
+		AlarmTemplateID:       d.Get("alarm_template_id").(string),
+		Enabled:               d.Get("enabled").(bool),
+		alarmNotificationOpts: getAlarmNotificationOpts(d),
+	}
+	log.Printf("[DEBUG] Create %s Options: %#v", nameCESARv2, createOpts)
+
+	id, err := createAlarmRuleV2(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating %s: %s", nameCESARv2, err)
+	}
+	d.SetId(id)
+
+	return resourceAlarmRuleV2Read(d, meta)
+}
+
+func resourceAlarmRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	alarm, err := getAlarmRuleV2(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "alarmrule")
+	}
+	log.Printf("[DEBUG] Retrieved %s %s: %#v", nameCESARv2, d.Id(), alarm)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", alarm.Name),
+		d.Set("description", alarm.Description),
+		d.Set("namespace", alarm.Namespace),
+		d.Set("type", alarm.Type),
+		d.Set("resource_group_id", alarm.ResourceGroupID),
+		d.Set("policies", flattenAlarmPolicies(alarm.Policies)),
+		d.Set("alarm_template_id", alarm.AlarmTemplateID),
+		d.Set("alarm_notifications", flattenAlarmNotifications(alarm.AlarmNotifications)),
+		d.Set("ok_notifications", flattenAlarmNotifications(alarm.OkNotifications)),
+		d.Set("notification_enabled", alarm.NotificationEnabled),
+		d.Set("notification_begin_time", alarm.NotificationBeginTime),
+		d.Set("notification_end_time", alarm.NotificationEndTime),
+		d.Set("enabled", alarm.Enabled),
+	)
+	// resources of the group are managed by the group itself
+	if alarm.ResourceGroupID == "" {
+		mErr = multierror.Append(mErr, d.Set("resources", flattenAlarmResources(alarm.Resources)))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+func resourceAlarmRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	id := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	retryUpdate := func(update func() error) error {
+		return resource.Retry(timeout, func() *resource.RetryError {
+			if err := update(); err != nil {
+				return common.CheckForRetryableError(err)
+			}
+			return nil
+		})
+	}
+
+	if d.HasChanges("name", "description") {
+		body := map[string]interface{}{
+			"name":        d.Get("name").(string),
+			"description": d.Get("description").(string),
+		}
+		err := retryUpdate(func() error {
+			return updateAlarmRuleV2(client, id, body)
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating %s %s: %s", nameCESARv2, id, err)
+		}
+	}
+
+	if d.HasChange("policies") {
+		policies := expandAlarmPolicies(d.Get("policies").([]interface{}))
+		err := retryUpdate(func() error {
+			return updateAlarmPoliciesV2(client, id, policies)
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating policies of %s %s: %s", nameCESARv2, id, err)
+		}
+	}
+
+	if d.HasChange("resources") {
+		oldRaw, newRaw := d.GetChange("resources")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+		if removed := oldSet.Difference(newSet); removed.Len() > 0 {
+			err := retryUpdate(func() error {
+				return updateAlarmResourcesV2(client, id, "batch-delete", expandAlarmResources(removed.List()))
+			})
+			if err != nil {
+				return fmt.Errorf("Error removing resources of %s %s: %s", nameCESARv2, id, err)
+			}
+		}
+		if added := newSet.Difference(oldSet); added.Len() > 0 {
+			err := retryUpdate(func() error {
+				return updateAlarmResourcesV2(client, id, "batch-create", expandAlarmResources(added.List()))
+			})
+			if err != nil {
+				return fmt.Errorf("Error adding resources of %s %s: %s", nameCESARv2, id, err)
+			}
+		}
+	}
+
+	if d.HasChanges("alarm_notifications", "ok_notifications", "notification_enabled",
+		"notification_begin_time", "notification_end_time") {
+		opts := getAlarmNotificationOpts(d)
+		err := retryUpdate(func() error {
+			return updateAlarmNotificationsV2(client, id, opts)
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating notifications of %s %s: %s", nameCESARv2, id, err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		enabled := d.Get("enabled").(bool)
+		err := retryUpdate(func() error {
+			return setAlarmEnabledV2(client, id, enabled)
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating %s %s: %s", nameCESARv2, id, err)
+		}
+	}
+
+	return resourceAlarmRuleV2Read(d, meta)
+}
+
+func resourceAlarmRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CesV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	id := d.Id()
+	log.Printf("[DEBUG] Deleting %s %s", nameCESARv2, id)
+
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := deleteAlarmRuleV2(client, id); err != nil {
+			return common.CheckForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if common.IsResourceNotFound(err) {
+			log.Printf("[INFO] deleting an unavailable %s: %s", nameCESARv2, id)
+			return nil
+		}
+		return fmt.Errorf("Error deleting %s %s: %s", nameCESARv2, id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package css
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/clusters"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/snapshots"
+)
+
+// updateSnapshotSetting sets OBS bucket, base path and agency used for cluster snapshots
+func updateSnapshotSetting(client *golangsdk.ServiceClient, clusterID, bucket, basePath, agency string) error {
+	body := map[string]interface{}{
+		"bucket":   bucket,
+		"basePath": basePath,
+		"agency":   agency,
+	}
+	_, err := client.Post(client.ServiceURL("clusters", clusterID, "index_snapshot", "setting"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// resizeClusterFlavor changes the flavor of cluster nodes
+func resizeClusterFlavor(client *golangsdk.ServiceClient, clusterID, flavorID string) error {
+	body := map[string]interface{}{
+		"needCheckReplica": true,
+		"newFlavorId":      flavorID,
+	}
+	_, err := client.Post(client.ServiceURL("clusters", clusterID, "flavor"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// restoreSnapshot restores the snapshot of the cluster into the target cluster
+func restoreSnapshot(client *golangsdk.ServiceClient, clusterID, snapshotID string, opts map[string]interface{}) error {
+	_, err := client.Post(client.ServiceURL("clusters", clusterID, "index_snapshot", snapshotID, "restore"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return err
+}
+
+func getSnapshot(client *golangsdk.ServiceClient, clusterID, snapshotID string) (*snapshots.Snapshot, error) {
+	list, err := snapshots.List(client, clusterID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	for _, snapshot := range list {
+		if snapshot.ID == snapshotID {
+			return &snapshot, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{
+		ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+			Body: []byte(fmt.Sprintf("snapshot %s of CSS cluster %s not found", snapshotID, clusterID)),
+		},
+	}
+}
+
+// waitForClusterAction waits until the cluster has no actions in progress
+func waitForClusterAction(client *golangsdk.ServiceClient, clusterID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DONE"},
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := clusters.Get(client, clusterID).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			if cluster.Status == "303" {
+				return cluster, "", fmt.Errorf("cluster action failed: %+v", cluster.FailedReasons)
+			}
+			if len(cluster.Actions) > 0 || cluster.Status != "200" {
+				return cluster, "PENDING", nil
+			}
+			return cluster, "DONE", nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -39,14 +39,12 @@ func ResourceCssClusterV1() *schema.Resource {
 			"node_config": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"flavor": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"network_info": {
 							Type:     schema.TypeList,
@@ -76,14 +74,12 @@ func ResourceCssClusterV1() *schema.Resource {
 						"volume": {
 							Type:     schema.TypeList,
 							Required: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"size": {
 										Type:     schema.TypeInt,
 										Required: true,
-										ForceNew: true,
 									},
 									"volume_type": {
 										Type:     schema.TypeString,
@@ -318,30 +314,56 @@ func resourceCssClusterV1Update(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error creating CSS v1 client: %s", err)
 	}
 
-	if !d.HasChange("expect_node_num") {
-		return nil
+	if d.HasChange("node_config.0.flavor") {
+		flavorName := d.Get("node_config.0.flavor").(string)
+		flavor, err := findCssFlavor(client, flavorName)
+		if err != nil {
+			return err
+		}
+		if err := resizeClusterFlavor(client, d.Id(), flavor.FlavorID); err != nil {
+			return fmt.Errorf("error changing cluster flavor to %s: %s", flavorName, err)
+		}
+		if err := waitForClusterAction(client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for cluster flavor to be changed: %s", err)
+		}
 	}
 
-	oldNum, newNum := d.GetChange("expect_node_num")
-	diff := newNum.(int) - oldNum.(int)
-	if diff < 0 {
-		return fmt.Errorf("invalid number of new nodes: %d", diff)
+	if d.HasChange("node_config.0.volume.0.size") {
+		oldSize, newSize := d.GetChange("node_config.0.volume.0.size")
+		_, err = clusters.ExtendCluster(client, d.Id(), clusters.ClusterExtendSpecialOpts{
+			Type:     "ess",
+			DiskSize: newSize.(int) - oldSize.(int),
+		}).Extract()
+		if err != nil {
+			return fmt.Errorf("error extending cluster volume: %s", err)
+		}
+		if err := waitForClusterAction(client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for cluster volume to be extended: %s", err)
+		}
 	}
 
-	_, err = clusters.ExtendCluster(client, d.Id(), clusters.ClusterExtendCommonOpts{
-		ModifySize: diff,
-	}).Extract()
-	if err != nil {
-		return fmt.Errorf("error extending cluster: %s", err)
-	}
+	if d.HasChange("expect_node_num") {
+		oldNum, newNum := d.GetChange("expect_node_num")
+		diff := newNum.(int) - oldNum.(int)
+		if diff < 0 {
+			return fmt.Errorf("invalid number of new nodes: %d", diff)
+		}
+
+		_, err = clusters.ExtendCluster(client, d.Id(), clusters.ClusterExtendCommonOpts{
+			ModifySize: diff,
+		}).Extract()
+		if err != nil {
+			return fmt.Errorf("error extending cluster: %s", err)
+		}
 
-	secondsWait := int(math.Round(d.Timeout(schema.TimeoutUpdate).Seconds()))
-	if err := clusters.WaitForClusterToExtend(client, d.Id(), secondsWait); err != nil {
-		state, _ := clusters.Get(client, d.Id()).Extract()
-		if state != nil {
-			return fmt.Errorf("error waiting cluster to extend: %s\nFail reason: %+v", err, state.FailedReasons)
+		secondsWait := int(math.Round(d.Timeout(schema.TimeoutUpdate).Seconds()))
+		if err := clusters.WaitForClusterToExtend(client, d.Id(), secondsWait); err != nil {
+			state, _ := clusters.Get(client, d.Id()).Extract()
+			if state != nil {
+				return fmt.Errorf("error waiting cluster to extend: %s\nFail reason: %+v", err, state.FailedReasons)
+			}
+			return fmt.Errorf("error waiting cluster to extend: %s", err)
 		}
-		return fmt.Errorf("error waiting cluster to extend: %s", err)
 	}
 
 	return resourceCssClusterV1Read(d, meta)
@@ -400,22 +422,40 @@ func checkCssClusterFlavorRestrictions(d *schema.ResourceDiff, meta interface{})
 	flavorName := d.Get("node_config.0.flavor").(string)
 	size := d.Get("node_config.0.volume.0.size").(int)
 
+	flavor, err := findCssFlavor(client, flavorName)
+	if err != nil {
+		return err
+	}
+
+	if size < flavor.DiskMin || size > flavor.DiskMax {
+		return fmt.Errorf("invalid disk size, `%s` support disk from %dGB to %dGB",
+			flavorName, flavor.DiskMin, flavor.DiskMax)
+	}
+
+	if d.Id() != "" && d.HasChange("node_config.0.volume.0.size") {
+		oldSize, newSize := d.GetChange("node_config.0.volume.0.size")
+		if newSize.(int) < oldSize.(int) {
+			return fmt.Errorf("cluster volume can't be shrunk: %dGB -> %dGB", oldSize, newSize)
+		}
+	}
+
+	return nil
+}
+
+func findCssFlavor(client *golangsdk.ServiceClient, flavorName string) (*flavors.Flavor, error) {
 	pages, err := flavors.List(client).AllPages()
 	if err != nil {
-		return fmt.Errorf("error retrieving flavor pages: %s", err)
+		return nil, fmt.Errorf("error retrieving flavor pages: %s", err)
 	}
 	versions, err := flavors.ExtractVersions(pages)
 	if err != nil {
-		return fmt.Errorf("error extracting flavor list: %s", err)
+		return nil, fmt.Errorf("error extracting flavor list: %s", err)
 	}
 	flavor := flavors.FindFlavor(versions, flavors.FilterOpts{
 		FlavorName: flavorName,
 	})
-
-	if size < flavor.DiskMin || size > flavor.DiskMax {
-		return fmt.Errorf("invalid disk size, `%s` support disk from %dGB to %dGB",
-			flavorName, flavor.DiskMin, flavor.DiskMax)
+	if flavor == nil {
+		return nil, fmt.Errorf("CSS flavor %s not found", flavorName)
 	}
-
-	return nil
+	return flavor, nil
 }
@@ -0,0 +1,224 @@
+package css
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/snapshots"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceCssSnapshotConfigurationV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCssSnapshotConfigurationV1Create,
+		Read:   resourceCssSnapshotConfigurationV1Read,
+		Update: resourceCssSnapshotConfigurationV1Update,
+		Delete: resourceCssSnapshotConfigurationV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"automatic": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"configuration"},
+			},
+			"configuration": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"automatic"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"base_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"agency": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"creation_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"period": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"keepday": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 90),
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"delete_auto": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCssSnapshotConfigurationV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	if d.Get("automatic").(bool) {
+		if err := snapshots.Enable(client, clusterID).ExtractErr(); err != nil {
+			return fmt.Errorf("error enabling automatic snapshot configuration: %s", err)
+		}
+	}
+	d.SetId(clusterID)
+
+	if err := updateCssSnapshotConfiguration(d, meta); err != nil {
+		return err
+	}
+
+	return resourceCssSnapshotConfigurationV1Read(d, meta)
+}
+
+func resourceCssSnapshotConfigurationV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	policy, err := snapshots.PolicyGet(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeleted(d, err, "CSS snapshot configuration")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("cluster_id", d.Id()),
+		d.Set("configuration", []map[string]interface{}{
+			{
+				"bucket":    policy.Bucket,
+				"base_path": policy.BasePath,
+				"agency":    policy.Agency,
+			},
+		}),
+	)
+	if _, ok := d.GetOk("creation_policy"); ok {
+		enable, _ := strconv.ParseBool(policy.Enable)
+		mErr = multierror.Append(mErr, d.Set("creation_policy", []map[string]interface{}{
+			{
+				"prefix":      policy.Prefix,
+				"period":      policy.Period,
+				"keepday":     policy.KeepDay,
+				"enable":      enable,
+				"delete_auto": d.Get("creation_policy.0.delete_auto").(bool),
+			},
+		}))
+	}
+	return mErr.ErrorOrNil()
+}
+
+func resourceCssSnapshotConfigurationV1Update(d *schema.ResourceData, meta interface{}) error {
+	if err := updateCssSnapshotConfiguration(d, meta); err != nil {
+		return err
+	}
+	return resourceCssSnapshotConfigurationV1Read(d, meta)
+}
+
+func updateCssSnapshotConfiguration(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	if d.HasChange("configuration") && !d.Get("automatic").(bool) {
+		if _, ok := d.GetOk("configuration"); ok {
+			err := updateSnapshotSetting(client, d.Id(),
+				d.Get("configuration.0.bucket").(string),
+				d.Get("configuration.0.base_path").(string),
+				d.Get("configuration.0.agency").(string),
+			)
+			if err != nil {
+				return fmt.Errorf("error updating CSS snapshot configuration: %s", err)
+			}
+		}
+	}
+
+	if d.HasChange("creation_policy") {
+		oldRaw, newRaw := d.GetChange("creation_policy")
+		var opts snapshots.PolicyCreateOpts
+		switch {
+		case len(newRaw.([]interface{})) > 0:
+			opts = expandCssSnapshotCreationPolicy(newRaw.([]interface{})[0].(map[string]interface{}), true)
+		case len(oldRaw.([]interface{})) > 0:
+			// removed policy is disabled
+			opts = expandCssSnapshotCreationPolicy(oldRaw.([]interface{})[0].(map[string]interface{}), false)
+		default:
+			return nil
+		}
+		if err := snapshots.PolicyCreate(client, opts, d.Id()).ExtractErr(); err != nil {
+			return fmt.Errorf("error setting CSS snapshot creation policy: %s", err)
+		}
+	}
+	return nil
+}
+
+func expandCssSnapshotCreationPolicy(policy map[string]interface{}, enabled bool) snapshots.PolicyCreateOpts {
+	return snapshots.PolicyCreateOpts{
+		Prefix:     policy["prefix"].(string),
+		Period:     policy["period"].(string),
+		KeepDay:    policy["keepday"].(int),
+		Enable:     strconv.FormatBool(enabled && policy["enable"].(bool)),
+		DeleteAuto: strconv.FormatBool(policy["delete_auto"].(bool)),
+	}
+}
+
+func resourceCssSnapshotConfigurationV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	if err := snapshots.Disable(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error disabling CSS snapshots")
+	}
+
+	d.SetId("")
+	return nil
+}
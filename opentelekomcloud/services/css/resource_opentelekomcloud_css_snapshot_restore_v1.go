@@ -0,0 +1,124 @@
+package css
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceCssSnapshotRestoreV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCssSnapshotRestoreV1Create,
+		Read:   resourceCssSnapshotRestoreV1Read,
+		Delete: resourceCssSnapshotRestoreV1Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"indices": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rename_pattern": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rename_replacement": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"restore_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCssSnapshotRestoreV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	snapshotID := d.Get("snapshot_id").(string)
+	targetClusterID := d.Get("target_cluster_id").(string)
+	opts := map[string]interface{}{
+		"targetCluster": targetClusterID,
+	}
+	for key, opt := range map[string]string{
+		"indices":            "indices",
+		"rename_pattern":     "renamePattern",
+		"rename_replacement": "renameReplacement",
+	} {
+		if v := d.Get(key).(string); v != "" {
+			opts[opt] = v
+		}
+	}
+	log.Printf("[DEBUG] Restoring CSS snapshot %s: %#v", snapshotID, opts)
+
+	if err := restoreSnapshot(client, clusterID, snapshotID, opts); err != nil {
+		return fmt.Errorf("error restoring CSS snapshot: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", snapshotID, targetClusterID))
+
+	if err := waitForClusterAction(client, targetClusterID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for CSS snapshot to be restored into cluster %s: %s", targetClusterID, err)
+	}
+
+	return resourceCssSnapshotRestoreV1Read(d, meta)
+}
+
+func resourceCssSnapshotRestoreV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	snapshot, err := getSnapshot(client, d.Get("cluster_id").(string), d.Get("snapshot_id").(string))
+	if err != nil {
+		return common.CheckDeleted(d, err, "CSS snapshot")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("restore_status", snapshot.RestoreStatus),
+	)
+	return mErr.ErrorOrNil()
+}
+
+// resourceCssSnapshotRestoreV1Delete only removes the restoration from the state,
+// restored indices are kept in the target cluster
+func resourceCssSnapshotRestoreV1Delete(d *schema.ResourceData, _ interface{}) error {
+	log.Printf("[WARN] CSS snapshot restoration %s can't be deleted, removing it from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
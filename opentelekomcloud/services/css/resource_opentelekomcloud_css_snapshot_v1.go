@@ -0,0 +1,179 @@
+package css
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/snapshots"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceCssSnapshotV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCssSnapshotV1Create,
+		Read:   resourceCssSnapshotV1Read,
+		Delete: resourceCssSnapshotV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCssSnapshotV1Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"indices": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"restore_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCssSnapshotV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	opts := snapshots.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Indices:     d.Get("indices").(string),
+	}
+	snapshot, err := snapshots.Create(client, opts, clusterID).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating CSS snapshot: %s", err)
+	}
+	d.SetId(snapshot.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"BUILDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			s, err := getSnapshot(client, clusterID, snapshot.ID)
+			if err != nil {
+				return nil, "", err
+			}
+			if s.Status == "FAILED" {
+				return s, s.Status, fmt.Errorf("snapshot creation failed")
+			}
+			return s, s.Status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CSS snapshot (%s) to be created: %s", snapshot.ID, err)
+	}
+
+	return resourceCssSnapshotV1Read(d, meta)
+}
+
+func resourceCssSnapshotV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	snapshot, err := getSnapshot(client, d.Get("cluster_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "CSS snapshot")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("cluster_id", snapshot.ClusterID),
+		d.Set("name", snapshot.Name),
+		d.Set("description", snapshot.Description),
+		d.Set("indices", snapshot.Indices),
+		d.Set("status", snapshot.Status),
+		d.Set("backup_type", snapshot.Type),
+		d.Set("backup_method", snapshot.Method),
+		d.Set("bucket_name", snapshot.Bucket),
+		d.Set("restore_status", snapshot.RestoreStatus),
+		d.Set("created", snapshot.Created),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceCssSnapshotV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	if err := snapshots.Delete(client, d.Get("cluster_id").(string), d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting CSS snapshot")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCssSnapshotV1Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for CSS snapshot, must be <cluster_id>/<snapshot_id>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("cluster_id", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
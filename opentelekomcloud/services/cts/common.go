@@ -0,0 +1,217 @@
+package cts
+
+import (
+	"net/url"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+func enabledStatus(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+type obsInfo struct {
+	BucketName     string `json:"bucket_name"`
+	FilePrefixName string `json:"file_prefix_name,omitempty"`
+}
+
+type dataBucket struct {
+	BucketName string   `json:"data_bucket_name"`
+	DataEvent  []string `json:"data_event"`
+}
+
+type dataTrackerOpts struct {
+	TrackerType  string      `json:"tracker_type"`
+	TrackerName  string      `json:"tracker_name"`
+	Status       string      `json:"status,omitempty"`
+	IsLTSEnabled bool        `json:"is_lts_enabled"`
+	ObsInfo      *obsInfo    `json:"obs_info,omitempty"`
+	DataBucket   *dataBucket `json:"data_bucket,omitempty"`
+}
+
+type dataTracker struct {
+	ID           string     `json:"id"`
+	TrackerName  string     `json:"tracker_name"`
+	TrackerType  string     `json:"tracker_type"`
+	Status       string     `json:"status"`
+	IsLTSEnabled bool       `json:"is_lts_enabled"`
+	ObsInfo      obsInfo    `json:"obs_info"`
+	DataBucket   dataBucket `json:"data_bucket"`
+	CreateTime   int64      `json:"create_time"`
+	LTS          struct {
+		LogGroupName string `json:"log_group_name"`
+		LogTopicName string `json:"log_topic_name"`
+	} `json:"lts"`
+}
+
+func createDataTracker(client *golangsdk.ServiceClient, opts dataTrackerOpts) error {
+	_, err := client.Post(client.ServiceURL("tracker"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return err
+}
+
+func getDataTracker(client *golangsdk.ServiceClient, name string) (*dataTracker, error) {
+	query := url.Values{}
+	query.Set("tracker_type", "data")
+	query.Set("tracker_name", name)
+	var result struct {
+		Trackers []dataTracker `json:"trackers"`
+	}
+	_, err := client.Get(client.ServiceURL("trackers")+"?"+query.Encode(), &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, tracker := range result.Trackers {
+		if tracker.TrackerName == name {
+			return &tracker, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func updateDataTracker(client *golangsdk.ServiceClient, opts dataTrackerOpts) error {
+	_, err := client.Put(client.ServiceURL("tracker"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteDataTracker(client *golangsdk.ServiceClient, name string) error {
+	query := url.Values{}
+	query.Set("tracker_type", "data")
+	query.Set("tracker_name", name)
+	_, err := client.Delete(client.ServiceURL("trackers")+"?"+query.Encode(), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+type notificationOperation struct {
+	ServiceType  string   `json:"service_type"`
+	ResourceType string   `json:"resource_type"`
+	TraceNames   []string `json:"trace_names"`
+}
+
+type notificationUsers struct {
+	UserGroup string   `json:"user_group"`
+	UserList  []string `json:"user_list"`
+}
+
+type notificationOpts struct {
+	ID             string                  `json:"notification_id,omitempty"`
+	Name           string                  `json:"notification_name"`
+	OperationType  string                  `json:"operation_type"`
+	Operations     []notificationOperation `json:"operations,omitempty"`
+	NotifyUserList []notificationUsers     `json:"notify_user_list,omitempty"`
+	Status         string                  `json:"status,omitempty"`
+	TopicID        string                  `json:"topic_id,omitempty"`
+}
+
+type notification struct {
+	ID             string                  `json:"notification_id"`
+	Name           string                  `json:"notification_name"`
+	OperationType  string                  `json:"operation_type"`
+	Operations     []notificationOperation `json:"operations"`
+	NotifyUserList []notificationUsers     `json:"notify_user_list"`
+	Status         string                  `json:"status"`
+	TopicID        string                  `json:"topic_id"`
+	CreateTime     int64                   `json:"create_time"`
+}
+
+func createNotification(client *golangsdk.ServiceClient, opts notificationOpts) (*notification, error) {
+	result := new(notification)
+	_, err := client.Post(client.ServiceURL("notifications"), opts, result, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return result, err
+}
+
+// getNotification looks for the notification in the list of SMN notifications, there is no API to get a single one
+func getNotification(client *golangsdk.ServiceClient, id string) (*notification, error) {
+	var result struct {
+		Notifications []notification `json:"notifications"`
+	}
+	_, err := client.Get(client.ServiceURL("notifications", "smn"), &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range result.Notifications {
+		if n.ID == id {
+			return &n, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func updateNotification(client *golangsdk.ServiceClient, opts notificationOpts) error {
+	_, err := client.Put(client.ServiceURL("notifications"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteNotification(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("notifications")+"?notification_id="+url.QueryEscape(id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+type traceUser struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Domain struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"domain"`
+}
+
+type trace struct {
+	ID           string    `json:"trace_id"`
+	Name         string    `json:"trace_name"`
+	Rating       string    `json:"trace_rating"`
+	Type         string    `json:"trace_type"`
+	ServiceType  string    `json:"service_type"`
+	ResourceType string    `json:"resource_type"`
+	ResourceID   string    `json:"resource_id"`
+	ResourceName string    `json:"resource_name"`
+	SourceIP     string    `json:"source_ip"`
+	Code         string    `json:"code"`
+	Message      string    `json:"message"`
+	Request      string    `json:"request"`
+	Response     string    `json:"response"`
+	Time         int64     `json:"time"`
+	RecordTime   int64     `json:"record_time"`
+	User         traceUser `json:"user"`
+}
+
+// listTraces follows the `marker` of the response to list up to `max` traces matching the query
+func listTraces(client *golangsdk.ServiceClient, query url.Values, max int) ([]trace, error) {
+	var traces []trace
+	for len(traces) < max {
+		var result struct {
+			Traces   []trace `json:"traces"`
+			MetaData struct {
+				Count  int    `json:"count"`
+				Marker string `json:"marker"`
+			} `json:"meta_data"`
+		}
+		_, err := client.Get(client.ServiceURL("traces")+"?"+query.Encode(), &result, nil)
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, result.Traces...)
+		if result.MetaData.Marker == "" || len(result.Traces) == 0 {
+			break
+		}
+		query.Set("next", result.MetaData.Marker)
+	}
+	if len(traces) > max {
+		traces = traces[:max]
+	}
+	return traces, nil
+}
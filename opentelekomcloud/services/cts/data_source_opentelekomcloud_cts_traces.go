@@ -0,0 +1,233 @@
+package cts
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceCTSTraces() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCTSTracesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"trace_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "system",
+				ValidateFunc: validation.StringInSlice([]string{
+					"system", "data",
+				}, false),
+			},
+			"tracker_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trace_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trace_rating": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"normal", "warning", "incident",
+				}, false),
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+				ExactlyOneOf: []string{"from", "duration"},
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: common.ValidateDuration,
+				ExactlyOneOf: []string{"from", "duration"},
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"traces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rating": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"response": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func formatMilliseconds(ms int64) string {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
+
+func dataSourceCTSTracesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	ctsClient, err := config.CtsV3Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating cts Client: %s", err)
+	}
+
+	from, to := common.TimeRangeMilliseconds(d)
+	if from >= to {
+		return fmt.Errorf("start of the time range must be before its end")
+	}
+	limit := d.Get("limit").(int)
+
+	query := url.Values{}
+	query.Set("from", strconv.FormatInt(from, 10))
+	query.Set("to", strconv.FormatInt(to, 10))
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("trace_type", d.Get("trace_type").(string))
+	for _, arg := range []string{
+		"tracker_name", "service_type", "resource_type", "resource_id", "resource_name", "trace_name", "trace_rating", "user",
+	} {
+		if v, ok := d.GetOk(arg); ok {
+			query.Set(arg, v.(string))
+		}
+	}
+	log.Printf("[DEBUG] Querying CTS traces: %s", query.Encode())
+
+	traces, err := listTraces(ctsClient, query, limit)
+	if err != nil {
+		return fmt.Errorf("Error querying CTS traces: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(traces))
+	for i, t := range traces {
+		result[i] = map[string]interface{}{
+			"id":            t.ID,
+			"name":          t.Name,
+			"rating":        t.Rating,
+			"type":          t.Type,
+			"service_type":  t.ServiceType,
+			"resource_type": t.ResourceType,
+			"resource_id":   t.ResourceID,
+			"resource_name": t.ResourceName,
+			"source_ip":     t.SourceIP,
+			"code":          t.Code,
+			"message":       t.Message,
+			"request":       t.Request,
+			"response":      t.Response,
+			"user":          t.User.Name,
+			"time":          formatMilliseconds(t.Time),
+		}
+	}
+
+	query.Del("next")
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join([]string{
+		string(config.GetProjectName(d)), query.Encode(),
+	}, "|"))))
+
+	if err := d.Set("traces", result); err != nil {
+		return fmt.Errorf("Error setting CTS traces: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package cts
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceCTSDataTrackerV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCTSDataTrackerV3Create,
+		Read:   resourceCTSDataTrackerV3Read,
+		Update: resourceCTSDataTrackerV3Update,
+		Delete: resourceCTSDataTrackerV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_operation": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"READ", "WRITE",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"file_prefix_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: common.ValidateName,
+			},
+			"lts_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_topic_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildDataTrackerOpts(d *schema.ResourceData) dataTrackerOpts {
+	opts := dataTrackerOpts{
+		TrackerType:  "data",
+		TrackerName:  d.Get("name").(string),
+		IsLTSEnabled: d.Get("lts_enabled").(bool),
+		DataBucket: &dataBucket{
+			BucketName: d.Get("data_bucket").(string),
+			DataEvent:  common.ExpandToStringSlice(d.Get("data_operation").(*schema.Set).List()),
+		},
+	}
+	if bucketName := d.Get("bucket_name").(string); bucketName != "" {
+		opts.ObsInfo = &obsInfo{
+			BucketName:     bucketName,
+			FilePrefixName: d.Get("file_prefix_name").(string),
+		}
+	} else if d.HasChange("bucket_name") {
+		// empty bucket name stops transferring traces to OBS
+		opts.ObsInfo = &obsInfo{}
+	}
+	return opts
+}
+
+func resourceCTSDataTrackerV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	ctsClient, err := config.CtsV3Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating cts Client: %s", err)
+	}
+
+	createOpts := buildDataTrackerOpts(d)
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	if err := createDataTracker(ctsClient, createOpts); err != nil {
+		return fmt.Errorf("Error creating CTS data tracker: %s", err)
+	}
+	d.SetId(createOpts.TrackerName)
+
+	// trackers are created enabled
+	if !d.Get("enabled").(bool) {
+		createOpts.Status = enabledStatus(false)
+		if err := updateDataTracker(ctsClient, createOpts); err != nil {
+			return fmt.Errorf("Error disabling CTS data tracker: %s", err)
+		}
+	}
+
+	return resourceCTSDataTrackerV3Read(d, meta)
+}
+
+func resourceCTSDataTrackerV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	ctsClient, err := config.CtsV3Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating cts Client: %s", err)
+	}
+
+	tracker, err := getDataTracker(ctsClient, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "Error retrieving CTS data tracker")
+	}
+	log.Printf("[DEBUG] Retrieved CTS data tracker %s: %#v", d.Id(), tracker)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", tracker.TrackerName),
+		d.Set("data_bucket", tracker.DataBucket.BucketName),
+		d.Set("data_operation", tracker.DataBucket.DataEvent),
+		d.Set("bucket_name", tracker.ObsInfo.BucketName),
+		d.Set("file_prefix_name", tracker.ObsInfo.FilePrefixName),
+		d.Set("lts_enabled", tracker.IsLTSEnabled),
+		d.Set("enabled", tracker.Status == "enabled"),
+		d.Set("status", tracker.Status),
+		d.Set("log_group_name", tracker.LTS.LogGroupName),
+		d.Set("log_topic_name", tracker.LTS.LogTopicName),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceCTSDataTrackerV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	ctsClient, err := config.CtsV3Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating cts Client: %s", err)
+	}
+
+	updateOpts := buildDataTrackerOpts(d)
+	updateOpts.Status = enabledStatus(d.Get("enabled").(bool))
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+	if err := updateDataTracker(ctsClient, updateOpts); err != nil {
+		return fmt.Errorf("Error updating CTS data tracker: %s", err)
+	}
+
+	return resourceCTSDataTrackerV3Read(d, meta)
+}
+
+func resourceCTSDataTrackerV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	ctsClient, err := config.CtsV3Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating cts Client: %s", err)
+	}
+
+	if err := deleteDataTracker(ctsClient, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "Error deleting CTS data tracker")
+	}
+
+	d.SetId("")
+	return nil
+}
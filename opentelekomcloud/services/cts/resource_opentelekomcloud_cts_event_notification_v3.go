@@ -0,0 +1,242 @@
+package cts
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceCTSEventNotificationV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCTSEventNotificationV3Create,
+		Read:   resourceCTSEventNotificationV3Read,
+		Update: resourceCTSEventNotificationV3Update,
+		Delete: resourceCTSEventNotificationV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: validateNotificationOperations,
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"operation_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"complete", "customized",
+				}, false),
+			},
+			"operations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"trace_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+			"notify_user_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_group": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"user_list": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+			"topic_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateNotificationOperations requires operations for `customized` operation type
+func validateNotificationOperations(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("operation_type").(string) == "customized" && len(d.Get("operations").([]interface{})) == 0 {
+		return fmt.Errorf("operations must be set for customized operation_type")
+	}
+	return nil
+}
+
+func buildNotificationOpts(d *schema.ResourceData) notificationOpts {
+	opts := notificationOpts{
+		Name:          d.Get("name").(string),
+		OperationType: d.Get("operation_type").(string),
+		TopicID:       d.Get("topic_id").(string),
+	}
+
+	for _, raw := range d.Get("operations").([]interface{}) {
+		operation := raw.(map[string]interface{})
+		opts.Operations = append(opts.Operations, notificationOperation{
+			ServiceType:  operation["service_type"].(string),
+			ResourceType: operation["resource_type"].(string),
+			TraceNames:   common.ExpandToStringSlice(operation["trace_names"].(*schema.Set).List()),
+		})
+	}
+
+	for _, raw := range d.Get("notify_user_list").([]interface{}) {
+		users := raw.(map[string]interface{})
+		opts.NotifyUserList = append(opts.NotifyUserList, notificationUsers{
+			UserGroup: users["user_group"].(string),
+			UserList:  common.ExpandToStringSlice(users["user_list"].(*schema.Set).List()),
+		})
+	}
+	return opts
+}
+
+func resourceCTSEventNotificationV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	ctsClient, err := config.CtsV3Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating cts Client: %s", err)
+	}
+
+	createOpts := buildNotificationOpts(d)
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	n, err := createNotification(ctsClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating CTS event notification: %s", err)
+	}
+	d.SetId(n.ID)
+
+	// notifications are created enabled
+	if !d.Get("enabled").(bool) {
+		createOpts.ID = n.ID
+		createOpts.Status = enabledStatus(false)
+		if err := updateNotification(ctsClient, createOpts); err != nil {
+			return fmt.Errorf("Error disabling CTS event notification: %s", err)
+		}
+	}
+
+	return resourceCTSEventNotificationV3Read(d, meta)
+}
+
+func resourceCTSEventNotificationV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	ctsClient, err := config.CtsV3Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating cts Client: %s", err)
+	}
+
+	n, err := getNotification(ctsClient, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "Error retrieving CTS event notification")
+	}
+	log.Printf("[DEBUG] Retrieved CTS event notification %s: %#v", d.Id(), n)
+
+	operations := make([]map[string]interface{}, len(n.Operations))
+	for i, operation := range n.Operations {
+		operations[i] = map[string]interface{}{
+			"service_type":  operation.ServiceType,
+			"resource_type": operation.ResourceType,
+			"trace_names":   operation.TraceNames,
+		}
+	}
+	userList := make([]map[string]interface{}, len(n.NotifyUserList))
+	for i, users := range n.NotifyUserList {
+		userList[i] = map[string]interface{}{
+			"user_group": users.UserGroup,
+			"user_list":  users.UserList,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", n.Name),
+		d.Set("operation_type", n.OperationType),
+		d.Set("operations", operations),
+		d.Set("notify_user_list", userList),
+		d.Set("topic_id", n.TopicID),
+		d.Set("enabled", n.Status == "enabled"),
+		d.Set("status", n.Status),
+		d.Set("create_time", n.CreateTime),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceCTSEventNotificationV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	ctsClient, err := config.CtsV3Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating cts Client: %s", err)
+	}
+
+	updateOpts := buildNotificationOpts(d)
+	updateOpts.ID = d.Id()
+	updateOpts.Status = enabledStatus(d.Get("enabled").(bool))
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+	if err := updateNotification(ctsClient, updateOpts); err != nil {
+		return fmt.Errorf("Error updating CTS event notification: %s", err)
+	}
+
+	return resourceCTSEventNotificationV3Read(d, meta)
+}
+
+func resourceCTSEventNotificationV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	ctsClient, err := config.CtsV3Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating cts Client: %s", err)
+	}
+
+	if err := deleteNotification(ctsClient, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "Error deleting CTS event notification")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,212 @@
+package dcs
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// RedisConfig describes a single Redis configuration parameter of an instance.
+type RedisConfig struct {
+	ParamID      string `json:"param_id"`
+	ParamName    string `json:"param_name"`
+	ParamValue   string `json:"param_value"`
+	DefaultValue string `json:"default_value,omitempty"`
+	ValueType    string `json:"value_type,omitempty"`
+	ValueRange   string `json:"value_range,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// InstanceConfig is the effective configuration of an instance.
+type InstanceConfig struct {
+	InstanceID   string        `json:"instance_id"`
+	ConfigStatus string        `json:"config_status"`
+	ConfigTime   string        `json:"config_time"`
+	RedisConfigs []RedisConfig `json:"redis_config"`
+}
+
+// Backup describes a single instance backup record.
+type Backup struct {
+	ID               string `json:"backup_id"`
+	Name             string `json:"backup_name"`
+	InstanceID       string `json:"instance_id"`
+	Size             int    `json:"size"`
+	Period           string `json:"period"`
+	BackupType       string `json:"backup_type"`
+	BackupFormat     string `json:"backup_format"`
+	Progress         string `json:"progress"`
+	Status           string `json:"status"`
+	Remark           string `json:"remark"`
+	ErrorCode        string `json:"error_code"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+	IsSupportRestore string `json:"is_support_restore"`
+}
+
+// Restore describes a single instance restoration record.
+type Restore struct {
+	ID          string `json:"restore_id"`
+	BackupID    string `json:"backup_id"`
+	BackupName  string `json:"backup_name"`
+	RestoreName string `json:"restore_name"`
+	Progress    string `json:"progress"`
+	Status      string `json:"status"`
+	Remark      string `json:"backup_remark"`
+	ErrorCode   string `json:"error_code"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+func configsURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("instances", instanceID, "configs")
+}
+
+func backupsURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("instances", instanceID, "backups")
+}
+
+func restoresURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("instances", instanceID, "restores")
+}
+
+// getInstanceConfig returns the effective Redis configuration of the instance
+func getInstanceConfig(client *golangsdk.ServiceClient, instanceID string) (*InstanceConfig, error) {
+	var config InstanceConfig
+	_, err := client.Get(configsURL(client, instanceID), &config, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// updateInstanceConfig modifies given Redis configuration parameters of the instance
+func updateInstanceConfig(client *golangsdk.ServiceClient, instanceID string, configs []RedisConfig) error {
+	body := map[string]interface{}{
+		"redis_config": configs,
+	}
+	_, err := client.Put(configsURL(client, instanceID), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// createBackup starts a manual backup of the instance and returns the backup ID
+func createBackup(client *golangsdk.ServiceClient, instanceID, remark, format string) (string, error) {
+	body := map[string]interface{}{
+		"remark": remark,
+	}
+	if format != "" {
+		body["backup_format"] = format
+	}
+	var res struct {
+		BackupID string `json:"backup_id"`
+	}
+	_, err := client.Post(backupsURL(client, instanceID), body, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.BackupID, nil
+}
+
+// listBackups returns all backup records of the instance
+func listBackups(client *golangsdk.ServiceClient, instanceID string) ([]Backup, error) {
+	var backups []Backup
+	const limit = 100
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("start", strconv.Itoa(page))
+		query.Set("limit", strconv.Itoa(limit))
+
+		var res struct {
+			TotalNum int      `json:"total_num"`
+			Backups  []Backup `json:"backup_record_response"`
+		}
+		_, err := client.Get(backupsURL(client, instanceID)+"?"+query.Encode(), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, res.Backups...)
+		if len(res.Backups) < limit || len(backups) >= res.TotalNum {
+			return backups, nil
+		}
+	}
+}
+
+// getBackup returns the backup record with the given ID
+func getBackup(client *golangsdk.ServiceClient, instanceID, backupID string) (*Backup, error) {
+	backups, err := listBackups(client, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, backup := range backups {
+		if backup.ID == backupID {
+			return &backup, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{
+		ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+			Body: []byte(fmt.Sprintf("backup %s of instance %s not found", backupID, instanceID)),
+		},
+	}
+}
+
+// deleteBackup removes the backup with the given ID
+func deleteBackup(client *golangsdk.ServiceClient, instanceID, backupID string) error {
+	_, err := client.Delete(client.ServiceURL("instances", instanceID, "backups", backupID), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// createRestore restores the instance using the given backup and returns the restoration ID
+func createRestore(client *golangsdk.ServiceClient, instanceID, backupID, remark string) (string, error) {
+	body := map[string]interface{}{
+		"backup_id": backupID,
+		"remark":    remark,
+	}
+	var res struct {
+		RestoreID string `json:"restore_id"`
+	}
+	_, err := client.Post(restoresURL(client, instanceID), body, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.RestoreID, nil
+}
+
+// getRestore returns the restoration record with the given ID
+func getRestore(client *golangsdk.ServiceClient, instanceID, restoreID string) (*Restore, error) {
+	const limit = 100
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("start", strconv.Itoa(page))
+		query.Set("limit", strconv.Itoa(limit))
+
+		var res struct {
+			Restores []Restore `json:"restore_record_response"`
+		}
+		_, err := client.Get(restoresURL(client, instanceID)+"?"+query.Encode(), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, restore := range res.Restores {
+			if restore.ID == restoreID {
+				return &restore, nil
+			}
+		}
+		if len(res.Restores) < limit {
+			break
+		}
+	}
+	return nil, golangsdk.ErrDefault404{
+		ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+			Body: []byte(fmt.Sprintf("restoration %s of instance %s not found", restoreID, instanceID)),
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package dcs
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDcsBackupV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDcsBackupV1Create,
+		Read:   resourceDcsBackupV1Read,
+		Delete: resourceDcsBackupV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDcsBackupV1Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"backup_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"rdb", "aof"}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"backup_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_support_restore": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDcsBackupV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv1 client: %w", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	backupID, err := createBackup(client, instanceID, d.Get("description").(string), d.Get("backup_format").(string))
+	if err != nil {
+		return fmt.Errorf("error creating backup of DCS instance %s: %w", instanceID, err)
+	}
+	log.Printf("[INFO] DCS backup ID: %s", backupID)
+
+	d.SetId(backupID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"waiting", "backuping"},
+		Target:     []string{"succeed"},
+		Refresh:    dcsBackupV1StateRefreshFunc(client, instanceID, backupID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DCS backup (%s) to become ready: %w", backupID, err)
+	}
+
+	return resourceDcsBackupV1Read(d, meta)
+}
+
+func resourceDcsBackupV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv1 client: %w", err)
+	}
+
+	backup, err := getBackup(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "DCS backup")
+	}
+	if backup.Status == "deleted" || backup.Status == "expired" {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("description", backup.Remark),
+		d.Set("name", backup.Name),
+		d.Set("size", backup.Size),
+		d.Set("backup_type", backup.BackupType),
+		d.Set("status", backup.Status),
+		d.Set("is_support_restore", backup.IsSupportRestore == "TRUE"),
+		d.Set("created_at", backup.CreatedAt),
+	)
+	if backup.BackupFormat != "" {
+		mErr = multierror.Append(mErr, d.Set("backup_format", backup.BackupFormat))
+	}
+	return mErr.ErrorOrNil()
+}
+
+func resourceDcsBackupV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv1 client: %w", err)
+	}
+
+	if err := deleteBackup(client, d.Get("instance_id").(string), d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "error deleting DCS backup")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDcsBackupV1Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for DCS backup, must be <instance_id>/<backup_id>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("instance_id", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func dcsBackupV1StateRefreshFunc(client *golangsdk.ServiceClient, instanceID, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := getBackup(client, instanceID, backupID)
+		if err != nil {
+			return nil, "", err
+		}
+		if backup.Status == "failed" {
+			return backup, backup.Status, fmt.Errorf("backup failed with error code: %s", backup.ErrorCode)
+		}
+		return backup, backup.Status, nil
+	}
+}
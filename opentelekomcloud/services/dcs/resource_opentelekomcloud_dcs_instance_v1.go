@@ -25,6 +25,12 @@ func ResourceDcsInstanceV1() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -69,7 +75,7 @@ func ResourceDcsInstanceV1() *schema.Resource {
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"subnet_id": {
 				Type:     schema.TypeString,
@@ -166,6 +172,11 @@ func ResourceDcsInstanceV1() *schema.Resource {
 					},
 				},
 			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"order_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -319,6 +330,12 @@ func resourceDcsInstancesV1Create(d *schema.ResourceData, meta interface{}) erro
 	// Store the instance ID now
 	d.SetId(v.InstanceID)
 
+	if params := d.Get("parameters").(map[string]interface{}); len(params) > 0 {
+		if err := updateDcsInstanceParameters(d, DcsV1Client, nil, params); err != nil {
+			return err
+		}
+	}
+
 	return resourceDcsInstancesV1Read(d, meta)
 }
 
@@ -364,7 +381,24 @@ func resourceDcsInstancesV1Read(d *schema.ResourceData, meta interface{}) error
 		d.Set("access_user", v.AccessUser),
 		d.Set("ip", v.IP),
 	)
-	return mErr.ErrorOrNil()
+	if err := mErr.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	params := d.Get("parameters").(map[string]interface{})
+	instanceConfig, err := getInstanceConfig(DcsV1Client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error fetching DCS instance configuration: %w", err)
+	}
+	// parameters set by the user and parameters changed from defaults are tracked,
+	// so imported instances keep their custom configuration
+	effective := make(map[string]interface{})
+	for _, c := range instanceConfig.RedisConfigs {
+		if _, ok := params[c.ParamName]; ok || c.ParamValue != c.DefaultValue {
+			effective[c.ParamName] = c.ParamValue
+		}
+	}
+	return d.Set("parameters", effective)
 }
 
 func resourceDcsInstancesV1Update(d *schema.ResourceData, meta interface{}) error {
@@ -399,6 +433,14 @@ func resourceDcsInstancesV1Update(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error updating Dcs Instance: %s", err)
 	}
 
+	if d.HasChange("parameters") {
+		oldParams, newParams := d.GetChange("parameters")
+		err := updateDcsInstanceParameters(d, DcsV1Client, oldParams.(map[string]interface{}), newParams.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceDcsInstancesV1Read(d, meta)
 }
 
@@ -456,3 +498,74 @@ func DcsInstancesV1StateRefreshFunc(client *golangsdk.ServiceClient, instanceID
 		return v, v.Status, nil
 	}
 }
+
+// updateDcsInstanceParameters applies changed Redis configuration parameters,
+// parameters removed from the configuration are reset to their default values.
+func updateDcsInstanceParameters(d *schema.ResourceData, client *golangsdk.ServiceClient, oldParams, newParams map[string]interface{}) error {
+	config, err := getInstanceConfig(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error fetching DCS instance configuration: %w", err)
+	}
+
+	var changed []RedisConfig
+	known := make(map[string]bool)
+	for _, c := range config.RedisConfigs {
+		known[c.ParamName] = true
+		if value, ok := newParams[c.ParamName]; ok {
+			if value.(string) != c.ParamValue {
+				changed = append(changed, RedisConfig{
+					ParamID:    c.ParamID,
+					ParamName:  c.ParamName,
+					ParamValue: value.(string),
+				})
+			}
+			continue
+		}
+		if _, ok := oldParams[c.ParamName]; ok && c.ParamValue != c.DefaultValue {
+			changed = append(changed, RedisConfig{
+				ParamID:    c.ParamID,
+				ParamName:  c.ParamName,
+				ParamValue: c.DefaultValue,
+			})
+		}
+	}
+	for name := range newParams {
+		if !known[name] {
+			return fmt.Errorf("parameter %s is not supported by DCS instance %s", name, d.Id())
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Updating DCS instance %s parameters: %#v", d.Id(), changed)
+	if err := updateInstanceConfig(client, d.Id(), changed); err != nil {
+		return fmt.Errorf("error updating DCS instance parameters: %w", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"UPDATING"},
+		Target:     []string{"SUCCESS"},
+		Refresh:    dcsInstanceConfigStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DCS instance (%s) parameters to be applied: %w", d.Id(), err)
+	}
+	return nil
+}
+
+func dcsInstanceConfigStateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		config, err := getInstanceConfig(client, instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+		if config.ConfigStatus == "FAILURE" {
+			return config, config.ConfigStatus, fmt.Errorf("DCS instance parameters update failed")
+		}
+		return config, config.ConfigStatus, nil
+	}
+}
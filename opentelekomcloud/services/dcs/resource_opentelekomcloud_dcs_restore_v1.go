@@ -0,0 +1,146 @@
+package dcs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDcsRestoreV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDcsRestoreV1Create,
+		Read:   resourceDcsRestoreV1Read,
+		Delete: resourceDcsRestoreV1Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"progress": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDcsRestoreV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv1 client: %w", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	restoreID, err := createRestore(client, instanceID, d.Get("backup_id").(string), d.Get("description").(string))
+	if err != nil {
+		return fmt.Errorf("error restoring DCS instance %s: %w", instanceID, err)
+	}
+	log.Printf("[INFO] DCS restoration ID: %s", restoreID)
+
+	d.SetId(restoreID)
+
+	// both waits share the create timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"waiting", "restoring"},
+		Target:     []string{"succeed"},
+		Refresh:    dcsRestoreV1StateRefreshFunc(client, instanceID, restoreID),
+		Timeout:    time.Until(deadline),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DCS instance (%s) to be restored: %w", instanceID, err)
+	}
+
+	stateConf = &resource.StateChangeConf{
+		Pending:    []string{"RESTORING", "RESTARTING"},
+		Target:     []string{"RUNNING"},
+		Refresh:    DcsInstancesV1StateRefreshFunc(client, instanceID),
+		Timeout:    time.Until(deadline),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DCS instance (%s) to become ready: %w", instanceID, err)
+	}
+
+	return resourceDcsRestoreV1Read(d, meta)
+}
+
+func resourceDcsRestoreV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv1 client: %w", err)
+	}
+
+	restore, err := getRestore(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "DCS restoration")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("backup_id", restore.BackupID),
+		d.Set("status", restore.Status),
+		d.Set("progress", restore.Progress),
+		d.Set("created_at", restore.CreatedAt),
+	)
+	return mErr.ErrorOrNil()
+}
+
+// resourceDcsRestoreV1Delete only removes the restoration from the state,
+// restoration records can't be deleted
+func resourceDcsRestoreV1Delete(d *schema.ResourceData, _ interface{}) error {
+	log.Printf("[WARN] DCS restoration %s can't be deleted, removing it from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func dcsRestoreV1StateRefreshFunc(client *golangsdk.ServiceClient, instanceID, restoreID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		restore, err := getRestore(client, instanceID, restoreID)
+		if err != nil {
+			return nil, "", err
+		}
+		if restore.Status == "failed" {
+			return restore, restore.Status, fmt.Errorf("restoration failed with error code: %s", restore.ErrorCode)
+		}
+		return restore, restore.Status, nil
+	}
+}
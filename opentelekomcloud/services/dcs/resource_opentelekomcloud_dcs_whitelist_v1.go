@@ -0,0 +1,146 @@
+package dcs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/whitelists"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDcsWhitelistV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDcsWhitelistV1Put,
+		Read:   resourceDcsWhitelistV1Read,
+		Update: resourceDcsWhitelistV1Put,
+		Delete: resourceDcsWhitelistV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enable_whitelist": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"whitelist": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ip_list": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getDcsWhitelistGroups(d *schema.ResourceData) []whitelists.WhitelistGroupOpts {
+	groupsRaw := d.Get("whitelist").([]interface{})
+	groups := make([]whitelists.WhitelistGroupOpts, len(groupsRaw))
+	for i, raw := range groupsRaw {
+		group := raw.(map[string]interface{})
+		groups[i] = whitelists.WhitelistGroupOpts{
+			GroupName: group["group_name"].(string),
+			IPList:    common.ExpandToStringSlice(group["ip_list"].([]interface{})),
+		}
+	}
+	return groups
+}
+
+func resourceDcsWhitelistV1Put(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %w", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	enable := d.Get("enable_whitelist").(bool)
+	opts := whitelists.WhitelistOpts{
+		Enable: &enable,
+		Groups: getDcsWhitelistGroups(d),
+	}
+	log.Printf("[DEBUG] Setting DCS instance %s whitelist: %#v", instanceID, opts)
+	if err := whitelists.Put(client, instanceID, opts).ExtractErr(); err != nil {
+		return fmt.Errorf("error setting whitelist of DCS instance %s: %w", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceDcsWhitelistV1Read(d, meta)
+}
+
+func resourceDcsWhitelistV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %w", err)
+	}
+
+	whitelist, err := whitelists.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeleted(d, err, "DCS instance whitelist")
+	}
+
+	groups := make([]map[string]interface{}, len(whitelist.Groups))
+	for i, group := range whitelist.Groups {
+		groups[i] = map[string]interface{}{
+			"group_name": group.GroupName,
+			"ip_list":    group.IPList,
+		}
+	}
+
+	if err := d.Set("instance_id", d.Id()); err != nil {
+		return fmt.Errorf("error setting instance_id: %w", err)
+	}
+	if err := d.Set("enable_whitelist", whitelist.Enable); err != nil {
+		return fmt.Errorf("error setting enable_whitelist: %w", err)
+	}
+	if err := d.Set("whitelist", groups); err != nil {
+		return fmt.Errorf("error setting whitelist: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDcsWhitelistV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %w", err)
+	}
+
+	enable := false
+	opts := whitelists.WhitelistOpts{
+		Enable: &enable,
+		Groups: []whitelists.WhitelistGroupOpts{},
+	}
+	if err := whitelists.Put(client, d.Id(), opts).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "DCS instance whitelist")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,388 @@
+package dds
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/instances"
+)
+
+// Job describes an asynchronous DDS task
+type Job struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Progress   string `json:"progress"`
+	FailReason string `json:"fail_reason"`
+}
+
+type jobResponse struct {
+	JobID string `json:"job_id"`
+}
+
+func actionURL(client *golangsdk.ServiceClient, instanceID, action string) string {
+	return client.ServiceURL("instances", instanceID, action)
+}
+
+func postInstanceAction(client *golangsdk.ServiceClient, instanceID, action string, body interface{}) (string, error) {
+	var res jobResponse
+	_, err := client.Post(actionURL(client, instanceID, action), body, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.JobID, nil
+}
+
+// enlargeInstance adds `num` nodes of the given type to the cluster instance
+func enlargeInstance(client *golangsdk.ServiceClient, instanceID, nodeType, specCode string, num, size int) (string, error) {
+	body := map[string]interface{}{
+		"type":      nodeType,
+		"spec_code": specCode,
+		"num":       num,
+	}
+	if size > 0 {
+		body["volume"] = map[string]interface{}{
+			"size": size,
+		}
+	}
+	return postInstanceAction(client, instanceID, "enlarge", body)
+}
+
+// resizeInstance changes the specification of the node or group identified with targetID,
+// for replica set instances targetType is empty and targetID is the ID of the instance
+func resizeInstance(client *golangsdk.ServiceClient, instanceID, targetType, targetID, specCode string) (string, error) {
+	resize := map[string]interface{}{
+		"target_id":        targetID,
+		"target_spec_code": specCode,
+	}
+	if targetType != "" {
+		resize["target_type"] = targetType
+	}
+	return postInstanceAction(client, instanceID, "resize", map[string]interface{}{"resize": resize})
+}
+
+// enlargeInstanceVolume extends storage of the shard group,
+// for replica set instances groupID is empty
+func enlargeInstanceVolume(client *golangsdk.ServiceClient, instanceID, groupID string, size int) (string, error) {
+	volume := map[string]interface{}{
+		"size": size,
+	}
+	if groupID != "" {
+		volume["group_id"] = groupID
+	}
+	return postInstanceAction(client, instanceID, "enlarge-volume", map[string]interface{}{"volume": volume})
+}
+
+// updateBackupPolicy sets the automated backup policy of the instance
+func updateBackupPolicy(client *golangsdk.ServiceClient, instanceID, startTime string, keepDays int) error {
+	body := map[string]interface{}{
+		"backup_policy": map[string]interface{}{
+			"start_time": startTime,
+			"keep_days":  keepDays,
+		},
+	}
+	_, err := client.Put(client.ServiceURL("instances", instanceID, "backups", "policy"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func getJob(client *golangsdk.ServiceClient, jobID string) (*Job, error) {
+	var res struct {
+		Job Job `json:"job"`
+	}
+	_, err := client.Get(client.ServiceURL("jobs")+"?id="+jobID, &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Job, nil
+}
+
+// waitForJob waits until DDS job is completed
+func waitForJob(client *golangsdk.ServiceClient, jobID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Running"},
+		Target:  []string{"Completed"},
+		Refresh: func() (interface{}, string, error) {
+			job, err := getJob(client, jobID)
+			if err != nil {
+				return nil, "", err
+			}
+			if job.Status == "Failed" {
+				return job, job.Status, fmt.Errorf("job %s (%s) failed: %s", job.ID, job.Name, job.FailReason)
+			}
+			return job, job.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      15 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// Backup describes a DDS instance backup
+type Backup struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	InstanceID   string  `json:"instance_id"`
+	InstanceName string  `json:"instance_name"`
+	Type         string  `json:"type"`
+	Size         float64 `json:"size"`
+	Status       string  `json:"status"`
+	Description  string  `json:"description"`
+	BeginTime    string  `json:"begin_time"`
+	EndTime      string  `json:"end_time"`
+	Datastore    struct {
+		Type    string `json:"type"`
+		Version string `json:"version"`
+	} `json:"datastore"`
+}
+
+// createBackup starts a manual backup of the instance and returns backup and job IDs
+func createBackup(client *golangsdk.ServiceClient, instanceID, name, description string) (string, string, error) {
+	body := map[string]interface{}{
+		"backup": map[string]interface{}{
+			"instance_id": instanceID,
+			"name":        name,
+			"description": description,
+		},
+	}
+	var res struct {
+		BackupID string `json:"backup_id"`
+		JobID    string `json:"job_id"`
+	}
+	_, err := client.Post(client.ServiceURL("backups"), body, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return res.BackupID, res.JobID, nil
+}
+
+func getBackup(client *golangsdk.ServiceClient, backupID string) (*Backup, error) {
+	var res struct {
+		Backups []Backup `json:"backups"`
+	}
+	_, err := client.Get(client.ServiceURL("backups")+"?backup_id="+backupID, &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Backups) == 0 {
+		return nil, golangsdk.ErrDefault404{
+			ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+				Body: []byte(fmt.Sprintf("DDS backup %s not found", backupID)),
+			},
+		}
+	}
+	return &res.Backups[0], nil
+}
+
+func deleteBackup(client *golangsdk.ServiceClient, backupID string) (string, error) {
+	var res jobResponse
+	_, err := client.Delete(client.ServiceURL("backups", backupID), &golangsdk.RequestOpts{
+		OkCodes:      []int{200, 202},
+		JSONResponse: &res,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.JobID, nil
+}
+
+// Configuration describes a DDS parameter template
+type Configuration struct {
+	ID               string                   `json:"id"`
+	Name             string                   `json:"name"`
+	Description      string                   `json:"description"`
+	DatastoreVersion string                   `json:"datastore_version"`
+	DatastoreName    string                   `json:"datastore_name"`
+	Created          string                   `json:"created"`
+	Updated          string                   `json:"updated"`
+	Parameters       []ConfigurationParameter `json:"parameters"`
+}
+
+// ConfigurationParameter describes a single parameter of DDS parameter template
+type ConfigurationParameter struct {
+	Name            string `json:"name"`
+	Value           string `json:"value"`
+	ValueRange      string `json:"value_range"`
+	Type            string `json:"type"`
+	Description     string `json:"description"`
+	RestartRequired bool   `json:"restart_required"`
+	ReadOnly        bool   `json:"readonly"`
+}
+
+func createConfiguration(client *golangsdk.ServiceClient, body map[string]interface{}) (string, error) {
+	var res struct {
+		Configuration struct {
+			ID string `json:"id"`
+		} `json:"configuration"`
+	}
+	_, err := client.Post(client.ServiceURL("configurations"), body, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Configuration.ID, nil
+}
+
+func getConfiguration(client *golangsdk.ServiceClient, configID string) (*Configuration, error) {
+	var res Configuration
+	_, err := client.Get(client.ServiceURL("configurations", configID), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func updateConfiguration(client *golangsdk.ServiceClient, configID string, body map[string]interface{}) error {
+	_, err := client.Put(client.ServiceURL("configurations", configID), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func deleteConfiguration(client *golangsdk.ServiceClient, configID string) error {
+	_, err := client.Delete(client.ServiceURL("configurations", configID), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// applyConfiguration applies the parameter template to instances, groups or nodes and returns the job ID
+func applyConfiguration(client *golangsdk.ServiceClient, configID string, entityIDs []string) (string, error) {
+	body := map[string]interface{}{
+		"entity_ids": entityIDs,
+	}
+	var res jobResponse
+	_, err := client.Put(client.ServiceURL("configurations", configID, "apply"), body, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.JobID, nil
+}
+
+// createInstance creates DDS instance using raw create options
+func createInstance(client *golangsdk.ServiceClient, opts map[string]interface{}) (*instances.Instance, error) {
+	var r instances.CreateResult
+	_, r.Err = client.Post(client.ServiceURL("instances"), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return r.Extract()
+}
+
+// ConfigurationSummary describes a DDS parameter template in the list of templates
+type ConfigurationSummary struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	DatastoreVersion string `json:"datastore_version"`
+	DatastoreName    string `json:"datastore_name"`
+	UserDefined      bool   `json:"user_defined"`
+}
+
+// ConfigurationHistory describes a single application of DDS parameter template to an instance
+type ConfigurationHistory struct {
+	InstanceID  string `json:"instance_id"`
+	AppliedAt   string `json:"applied_at"`
+	ApplyResult string `json:"apply_result"`
+}
+
+func listConfigurations(client *golangsdk.ServiceClient) ([]ConfigurationSummary, error) {
+	var res struct {
+		Configurations []ConfigurationSummary `json:"configurations"`
+	}
+	_, err := client.Get(client.ServiceURL("configurations"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Configurations, nil
+}
+
+func listConfigurationHistories(client *golangsdk.ServiceClient, configID string) ([]ConfigurationHistory, error) {
+	var res struct {
+		Histories []ConfigurationHistory `json:"histories"`
+	}
+	_, err := client.Get(client.ServiceURL("configurations", configID, "applied-histories"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Histories, nil
+}
+
+// getDefaultConfiguration returns the default parameter template for the database version and node type
+func getDefaultConfiguration(client *golangsdk.ServiceClient, version, nodeType string) (*Configuration, error) {
+	configurations, err := listConfigurations(client)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range configurations {
+		if !c.UserDefined && c.DatastoreVersion == version && c.DatastoreName == nodeType {
+			return getConfiguration(client, c.ID)
+		}
+	}
+	return nil, golangsdk.ErrDefault404{
+		ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+			Body: []byte(fmt.Sprintf("default DDS parameter template for %s %s not found", nodeType, version)),
+		},
+	}
+}
+
+// getAppliedConfigurations returns IDs of parameter templates last applied to the instance by node type,
+// node types reset to default templates are not included
+func getAppliedConfigurations(client *golangsdk.ServiceClient, instance *instances.InstanceResponse) (map[string]string, error) {
+	configurations, err := listConfigurations(client)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeTypes := ddsNodeTypes(instance)
+	type applied struct {
+		configuration ConfigurationSummary
+		appliedAt     string
+	}
+	latest := make(map[string]applied)
+	for _, c := range configurations {
+		if c.DatastoreVersion != instance.DataStore.Version || !nodeTypes[c.DatastoreName] {
+			continue
+		}
+		histories, err := listConfigurationHistories(client, c.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, history := range histories {
+			if history.InstanceID != instance.Id || history.ApplyResult != "SUCCESS" {
+				continue
+			}
+			if history.AppliedAt > latest[c.DatastoreName].appliedAt {
+				latest[c.DatastoreName] = applied{configuration: c, appliedAt: history.AppliedAt}
+			}
+		}
+	}
+
+	result := make(map[string]string)
+	for nodeType, a := range latest {
+		if a.configuration.UserDefined {
+			result[nodeType] = a.configuration.ID
+		} else {
+			result[nodeType] = ""
+		}
+	}
+	return result, nil
+}
+
+// ddsNodeTypes returns node types of the instance parameter templates can be applied to
+func ddsNodeTypes(instance *instances.InstanceResponse) map[string]bool {
+	if instance.Mode == "ReplicaSet" {
+		return map[string]bool{"replica": true}
+	}
+	return map[string]bool{"mongos": true, "shard": true, "config": true}
+}
@@ -0,0 +1,171 @@
+package dds
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDdsBackupV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDdsBackupV3Create,
+		Read:   resourceDdsBackupV3Read,
+		Delete: resourceDdsBackupV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"begin_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDdsBackupV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	backupID, jobID, err := createBackup(client, instanceID, d.Get("name").(string), d.Get("description").(string))
+	if err != nil {
+		return fmt.Errorf("error creating backup of DDS instance %s: %s", instanceID, err)
+	}
+	log.Printf("[DEBUG] Created DDS backup %s, job: %s", backupID, jobID)
+
+	d.SetId(backupID)
+
+	if err := waitForJob(client, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DDS backup (%s) to become ready: %s", backupID, err)
+	}
+
+	return resourceDdsBackupV3Read(d, meta)
+}
+
+func resourceDdsBackupV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	backup, err := getBackup(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching DDS backup")
+	}
+
+	datastore := []map[string]interface{}{
+		{
+			"type":    backup.Datastore.Type,
+			"version": backup.Datastore.Version,
+		},
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", backup.InstanceID),
+		d.Set("name", backup.Name),
+		d.Set("description", backup.Description),
+		d.Set("type", backup.Type),
+		d.Set("status", backup.Status),
+		d.Set("size", backup.Size),
+		d.Set("begin_time", backup.BeginTime),
+		d.Set("end_time", backup.EndTime),
+		d.Set("datastore", datastore),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceDdsBackupV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	jobID, err := deleteBackup(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error deleting DDS backup")
+	}
+	if jobID != "" {
+		if err := waitForJob(client, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("error waiting for DDS backup (%s) to be deleted: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -3,6 +3,7 @@ package dds
 import (
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -29,9 +30,12 @@ func ResourceDdsInstanceV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: validateDdsFlavorChange,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -111,7 +115,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			"flavor": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
@@ -125,7 +128,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 						"num": {
 							Type:         schema.TypeInt,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.IntBetween(1, 16),
 						},
 						"storage": {
@@ -139,12 +141,10 @@ func ResourceDdsInstanceV3() *schema.Resource {
 						"size": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 						},
 						"spec_code": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -152,7 +152,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			"backup_strategy": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -175,6 +174,53 @@ func ResourceDdsInstanceV3() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"restore_from": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"backup_id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_from.0.restore_time"},
+						},
+						"restore_time": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IsRFC3339Time,
+							ConflictsWith: []string{"restore_from.0.backup_id"},
+						},
+					},
+				},
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"mongos", "shard", "config", "replica",
+							}, false),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"db_username": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -278,6 +324,45 @@ func resourceDdsBackupStrategy(d *schema.ResourceData) instances.BackupStrategy
 	return backupStrategy
 }
 
+func resourceDdsRestorePoint(d *schema.ResourceData) (map[string]interface{}, error) {
+	restoreRaw := d.Get("restore_from").([]interface{})
+	if len(restoreRaw) == 0 {
+		return nil, nil
+	}
+	restore := restoreRaw[0].(map[string]interface{})
+	restorePoint := map[string]interface{}{
+		"instance_id": restore["instance_id"].(string),
+	}
+	if backupID := restore["backup_id"].(string); backupID != "" {
+		restorePoint["type"] = "backup"
+		restorePoint["backup_id"] = backupID
+		return restorePoint, nil
+	}
+	restoreTime := restore["restore_time"].(string)
+	if restoreTime == "" {
+		return nil, fmt.Errorf("one of `restore_from.0.backup_id` or `restore_from.0.restore_time` must be set")
+	}
+	timestamp, err := time.Parse(time.RFC3339, restoreTime)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing restore time: %s", err)
+	}
+	restorePoint["type"] = "timestamp"
+	restorePoint["restore_time"] = timestamp.UnixNano() / int64(time.Millisecond)
+	return restorePoint, nil
+}
+
+func resourceDdsConfigurations(d *schema.ResourceData) []map[string]interface{} {
+	var configurations []map[string]interface{}
+	for _, configurationRaw := range d.Get("configuration").([]interface{}) {
+		configuration := configurationRaw.(map[string]interface{})
+		configurations = append(configurations, map[string]interface{}{
+			"type":             configuration["type"].(string),
+			"configuration_id": configuration["id"].(string),
+		})
+	}
+	return configurations
+}
+
 func DdsInstanceStateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		opts := instances.ListInstanceOpts{
@@ -336,7 +421,22 @@ func resourceDdsInstanceV3Create(d *schema.ResourceData, meta interface{}) error
 	}
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
-	instance, err := instances.Create(client, createOpts).Extract()
+	createMap, err := createOpts.ToInstancesCreateMap()
+	if err != nil {
+		return fmt.Errorf("error building DDS instance create options: %s", err)
+	}
+	restorePoint, err := resourceDdsRestorePoint(d)
+	if err != nil {
+		return err
+	}
+	if restorePoint != nil {
+		createMap["restore_point"] = restorePoint
+	}
+	if configurations := resourceDdsConfigurations(d); len(configurations) > 0 {
+		createMap["configurations"] = configurations
+	}
+
+	instance, err := createInstance(client, createMap)
 	if err != nil {
 		return fmt.Errorf("Error getting instance from result: %s ", err)
 	}
@@ -438,9 +538,55 @@ func resourceDdsInstanceV3Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting nodes of DDSv3 instance: %s", err)
 	}
 
+	// looking up applied templates takes a request per template, so it's done only when templates are tracked
+	if len(resourceDdsConfigurations(d)) > 0 {
+		applied, err := getAppliedConfigurations(client, &instance)
+		if err != nil {
+			return fmt.Errorf("error fetching parameter templates of DDSv3 instance: %s", err)
+		}
+		if err := d.Set("configuration", flattenDdsInstanceV3Configurations(d, applied)); err != nil {
+			return fmt.Errorf("error setting configuration of DDSv3 instance: %s", err)
+		}
+	}
+
 	return mErr.ErrorOrNil()
 }
 
+// flattenDdsInstanceV3Configurations keeps the order of configured templates, templates without
+// application records (e.g. set on creation) are kept as is
+func flattenDdsInstanceV3Configurations(d *schema.ResourceData, applied map[string]string) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, configuration := range resourceDdsConfigurations(d) {
+		nodeType := configuration["type"].(string)
+		configurationID := configuration["configuration_id"].(string)
+		if appliedID, ok := applied[nodeType]; ok {
+			configurationID = appliedID
+			delete(applied, nodeType)
+		}
+		if configurationID != "" {
+			result = append(result, map[string]interface{}{
+				"type": nodeType,
+				"id":   configurationID,
+			})
+		}
+	}
+
+	var nodeTypes []string
+	for nodeType, configurationID := range applied {
+		if configurationID != "" {
+			nodeTypes = append(nodeTypes, nodeType)
+		}
+	}
+	sort.Strings(nodeTypes)
+	for _, nodeType := range nodeTypes {
+		result = append(result, map[string]interface{}{
+			"type": nodeType,
+			"id":   applied[nodeType],
+		})
+	}
+	return result
+}
+
 func resourceDdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.DdsV3Client(config.GetRegion(d))
@@ -498,11 +644,30 @@ func resourceDdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error updating instance from result: %s ", r.Err)
 	}
 
+	if d.HasChange("flavor") {
+		if err := updateDdsInstanceFlavors(d, client); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("configuration") {
+		if err := updateDdsInstanceConfigurations(d, client); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("backup_strategy") {
+		backupStrategy := resourceDdsBackupStrategy(d)
+		if err := updateBackupPolicy(client, d.Id(), backupStrategy.StartTime, backupStrategy.KeepDays); err != nil {
+			return fmt.Errorf("error updating backup strategy of DDS instance: %s", err)
+		}
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"updating"},
 		Target:     []string{"normal"},
 		Refresh:    DdsInstanceStateRefreshFunc(client, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      15 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -562,3 +727,209 @@ func flattenDdsInstanceV3Nodes(dds instances.InstanceResponse) interface{} {
 	}
 	return nodesList
 }
+
+func ddsFlavorsByType(flavorsRaw []interface{}) map[string]map[string]interface{} {
+	flavors := make(map[string]map[string]interface{})
+	for _, flavorRaw := range flavorsRaw {
+		flavor := flavorRaw.(map[string]interface{})
+		flavors[flavor["type"].(string)] = flavor
+	}
+	return flavors
+}
+
+// validateDdsFlavorChange checks that flavor changes can be applied in place
+func validateDdsFlavorChange(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("flavor") || !d.NewValueKnown("flavor") {
+		return nil
+	}
+	oldRaw, newRaw := d.GetChange("flavor")
+	oldFlavors := ddsFlavorsByType(oldRaw.([]interface{}))
+	newFlavors := ddsFlavorsByType(newRaw.([]interface{}))
+	if len(oldFlavors) != len(newFlavors) {
+		return d.ForceNew("flavor")
+	}
+	for nodeType, newFlavor := range newFlavors {
+		oldFlavor, ok := oldFlavors[nodeType]
+		if !ok {
+			return d.ForceNew("flavor")
+		}
+		oldNum, newNum := oldFlavor["num"].(int), newFlavor["num"].(int)
+		if newNum < oldNum {
+			return fmt.Errorf("number of %s nodes can't be decreased from %d to %d", nodeType, oldNum, newNum)
+		}
+		if newNum > oldNum && nodeType != "mongos" && nodeType != "shard" {
+			return fmt.Errorf("number of %s nodes can't be changed", nodeType)
+		}
+		oldSize, newSize := oldFlavor["size"].(int), newFlavor["size"].(int)
+		if newSize < oldSize {
+			return fmt.Errorf("disk size of %s nodes can't be decreased from %d to %d", nodeType, oldSize, newSize)
+		}
+		if newSize > oldSize && nodeType != "shard" && nodeType != "replica" {
+			return fmt.Errorf("disk size of %s nodes can't be changed", nodeType)
+		}
+	}
+	return nil
+}
+
+func getDdsInstance(client *golangsdk.ServiceClient, instanceID string) (*instances.InstanceResponse, error) {
+	allPages, err := instances.List(client, instances.ListInstanceOpts{Id: instanceID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	instancesList, err := instances.ExtractInstances(allPages)
+	if err != nil {
+		return nil, err
+	}
+	if len(instancesList.Instances) == 0 {
+		return nil, fmt.Errorf("DDS instance %s not found", instanceID)
+	}
+	return &instancesList.Instances[0], nil
+}
+
+// updateDdsInstanceFlavors changes specifications, disk size and node count of the instance.
+// Existing nodes are changed first, so nodes added afterwards get the new specification.
+func updateDdsInstanceFlavors(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	oldRaw, newRaw := d.GetChange("flavor")
+	oldFlavors := ddsFlavorsByType(oldRaw.([]interface{}))
+	newFlavors := ddsFlavorsByType(newRaw.([]interface{}))
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	instance, err := getDdsInstance(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+
+	for _, nodeType := range []string{"config", "mongos", "shard", "replica"} {
+		oldFlavor, ok := oldFlavors[nodeType]
+		if !ok {
+			continue
+		}
+		newFlavor := newFlavors[nodeType]
+		specCode := newFlavor["spec_code"].(string)
+		size := newFlavor["size"].(int)
+
+		if specCode != oldFlavor["spec_code"].(string) {
+			for _, target := range ddsEntityIDs(instance, nodeType) {
+				log.Printf("[DEBUG] Changing spec of DDS %s %s to %s", nodeType, target, specCode)
+				targetType := nodeType
+				if nodeType == "replica" {
+					targetType = ""
+				}
+				jobID, err := resizeInstance(client, d.Id(), targetType, target, specCode)
+				if err != nil {
+					return fmt.Errorf("error changing spec of DDS %s %s: %s", nodeType, target, err)
+				}
+				if err := waitForJob(client, jobID, timeout); err != nil {
+					return fmt.Errorf("error waiting for DDS %s %s spec to be changed: %s", nodeType, target, err)
+				}
+			}
+		}
+
+		if size > oldFlavor["size"].(int) {
+			var groupIDs []string
+			if nodeType == "shard" {
+				for _, group := range instance.Groups {
+					if group.Type == nodeType {
+						groupIDs = append(groupIDs, group.Id)
+					}
+				}
+			} else {
+				groupIDs = []string{""}
+			}
+			for _, groupID := range groupIDs {
+				log.Printf("[DEBUG] Extending DDS %s %s volume to %d GB", nodeType, groupID, size)
+				jobID, err := enlargeInstanceVolume(client, d.Id(), groupID, size)
+				if err != nil {
+					return fmt.Errorf("error extending DDS %s volume: %s", nodeType, err)
+				}
+				if err := waitForJob(client, jobID, timeout); err != nil {
+					return fmt.Errorf("error waiting for DDS %s volume to be extended: %s", nodeType, err)
+				}
+			}
+		}
+
+		if delta := newFlavor["num"].(int) - oldFlavor["num"].(int); delta > 0 {
+			nodeSize := 0
+			if nodeType == "shard" {
+				nodeSize = size
+			}
+			log.Printf("[DEBUG] Adding %d %s nodes to DDS instance %s", delta, nodeType, d.Id())
+			jobID, err := enlargeInstance(client, d.Id(), nodeType, specCode, delta, nodeSize)
+			if err != nil {
+				return fmt.Errorf("error adding %s nodes to DDS instance: %s", nodeType, err)
+			}
+			if err := waitForJob(client, jobID, timeout); err != nil {
+				return fmt.Errorf("error waiting for %s nodes to be added: %s", nodeType, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ddsEntityIDs returns IDs of objects to be resized or configured for the node type:
+// mongos nodes, shard and config groups or the instance itself for replica sets
+func ddsEntityIDs(instance *instances.InstanceResponse, nodeType string) []string {
+	if nodeType == "replica" {
+		return []string{instance.Id}
+	}
+	var targets []string
+	for _, group := range instance.Groups {
+		if group.Type != nodeType {
+			continue
+		}
+		if nodeType == "mongos" {
+			for _, node := range group.Nodes {
+				targets = append(targets, node.Id)
+			}
+			continue
+		}
+		targets = append(targets, group.Id)
+	}
+	return targets
+}
+
+// updateDdsInstanceConfigurations applies changed parameter templates to the nodes of the instance,
+// nodes with removed templates get the default template
+func updateDdsInstanceConfigurations(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	oldRaw, _ := d.GetChange("configuration")
+	oldConfigurations := make(map[string]string)
+	for _, configurationRaw := range oldRaw.([]interface{}) {
+		configuration := configurationRaw.(map[string]interface{})
+		oldConfigurations[configuration["type"].(string)] = configuration["id"].(string)
+	}
+
+	instance, err := getDdsInstance(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+
+	newConfigurations := make(map[string]string)
+	for _, configuration := range resourceDdsConfigurations(d) {
+		newConfigurations[configuration["type"].(string)] = configuration["configuration_id"].(string)
+	}
+	for nodeType := range oldConfigurations {
+		if _, ok := newConfigurations[nodeType]; ok {
+			continue
+		}
+		defaultConfiguration, err := getDefaultConfiguration(client, instance.DataStore.Version, nodeType)
+		if err != nil {
+			return fmt.Errorf("error fetching default DDS parameter template for %s nodes: %s", nodeType, err)
+		}
+		newConfigurations[nodeType] = defaultConfiguration.ID
+	}
+
+	for nodeType, configurationID := range newConfigurations {
+		if oldConfigurations[nodeType] == configurationID {
+			continue
+		}
+		log.Printf("[DEBUG] Applying DDS parameter template %s to %s nodes", configurationID, nodeType)
+		jobID, err := applyConfiguration(client, configurationID, ddsEntityIDs(instance, nodeType))
+		if err != nil {
+			return fmt.Errorf("error applying DDS parameter template %s: %s", configurationID, err)
+		}
+		if err := waitForJob(client, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for DDS parameter template %s to be applied: %s", configurationID, err)
+		}
+	}
+	return nil
+}
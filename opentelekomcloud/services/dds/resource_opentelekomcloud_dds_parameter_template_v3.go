@@ -0,0 +1,288 @@
+package dds
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDdsParameterTemplateV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDdsParameterTemplateV3Create,
+		Read:   resourceDdsParameterTemplateV3Read,
+		Update: resourceDdsParameterTemplateV3Update,
+		Delete: resourceDdsParameterTemplateV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"values": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"datastore": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "DDS-Community",
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"node_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"mongos", "shard", "config", "replica", "single",
+							}, false),
+						},
+					},
+				},
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"restart_required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"value_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDdsParameterTemplateV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	datastore := d.Get("datastore").([]interface{})[0].(map[string]interface{})
+	createOpts := map[string]interface{}{
+		"name":             d.Get("name").(string),
+		"description":      d.Get("description").(string),
+		"parameter_values": d.Get("values").(map[string]interface{}),
+		"datastore": map[string]interface{}{
+			"type":      datastore["type"].(string),
+			"version":   datastore["version"].(string),
+			"node_type": datastore["node_type"].(string),
+		},
+	}
+	log.Printf("[DEBUG] DDS parameter template create options: %#v", createOpts)
+
+	id, err := createConfiguration(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating DDS parameter template: %s", err)
+	}
+	d.SetId(id)
+
+	return resourceDdsParameterTemplateV3Read(d, meta)
+}
+
+func resourceDdsParameterTemplateV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	configuration, err := getConfiguration(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching DDS parameter template")
+	}
+
+	defaults, err := getDdsParameterDefaults(client, configuration)
+	if err != nil {
+		return err
+	}
+
+	// values set by the user and values changed from defaults are tracked
+	configured := d.Get("values").(map[string]interface{})
+	values := make(map[string]interface{})
+	parameters := make([]map[string]interface{}, len(configuration.Parameters))
+	for i, parameter := range configuration.Parameters {
+		parameters[i] = map[string]interface{}{
+			"name":             parameter.Name,
+			"value":            parameter.Value,
+			"restart_required": parameter.RestartRequired,
+			"readonly":         parameter.ReadOnly,
+			"value_range":      parameter.ValueRange,
+			"type":             parameter.Type,
+			"description":      parameter.Description,
+		}
+		if _, ok := configured[parameter.Name]; ok || parameter.Value != defaults[parameter.Name] {
+			values[parameter.Name] = parameter.Value
+		}
+	}
+
+	// datastore type is not returned by the API
+	datastoreType := "DDS-Community"
+	if v, ok := d.GetOk("datastore.0.type"); ok {
+		datastoreType = v.(string)
+	}
+	datastore := []map[string]interface{}{
+		{
+			"type":      datastoreType,
+			"version":   configuration.DatastoreVersion,
+			"node_type": configuration.DatastoreName,
+		},
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", configuration.Name),
+		d.Set("datastore", datastore),
+		d.Set("description", configuration.Description),
+		d.Set("values", values),
+		d.Set("parameters", parameters),
+		d.Set("created", configuration.Created),
+		d.Set("updated", configuration.Updated),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceDdsParameterTemplateV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	updateOpts := make(map[string]interface{})
+	if d.HasChange("name") {
+		updateOpts["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		updateOpts["description"] = d.Get("description").(string)
+	}
+	if d.HasChange("values") {
+		configuration, err := getConfiguration(client, d.Id())
+		if err != nil {
+			return fmt.Errorf("error fetching DDS parameter template: %s", err)
+		}
+		defaults, err := getDdsParameterDefaults(client, configuration)
+		if err != nil {
+			return err
+		}
+
+		// removed values are reset to defaults
+		oldValues, newValues := d.GetChange("values")
+		values := make(map[string]interface{})
+		for name := range oldValues.(map[string]interface{}) {
+			if value, ok := defaults[name]; ok {
+				values[name] = value
+			}
+		}
+		for name, value := range newValues.(map[string]interface{}) {
+			values[name] = value
+		}
+		updateOpts["parameter_values"] = values
+	}
+	log.Printf("[DEBUG] DDS parameter template update options: %#v", updateOpts)
+
+	if err := updateConfiguration(client, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("error updating DDS parameter template: %s", err)
+	}
+
+	return resourceDdsParameterTemplateV3Read(d, meta)
+}
+
+func resourceDdsParameterTemplateV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	if err := deleteConfiguration(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "error deleting DDS parameter template")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getDdsParameterDefaults returns parameter values of the default template
+// with the same database version and node type
+func getDdsParameterDefaults(client *golangsdk.ServiceClient, configuration *Configuration) (map[string]string, error) {
+	defaultConfiguration, err := getDefaultConfiguration(client, configuration.DatastoreVersion, configuration.DatastoreName)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching default DDS parameter template: %s", err)
+	}
+	defaults := make(map[string]string)
+	for _, parameter := range defaultConfiguration.Parameters {
+		defaults[parameter.Name] = parameter.Value
+	}
+	return defaults, nil
+}
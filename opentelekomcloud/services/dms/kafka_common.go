@@ -0,0 +1,290 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// KafkaInstance describes DMS for Kafka instance
+type KafkaInstance struct {
+	ID                       string   `json:"instance_id"`
+	Name                     string   `json:"name"`
+	Description              string   `json:"description"`
+	Engine                   string   `json:"engine"`
+	EngineVersion            string   `json:"engine_version"`
+	Specification            string   `json:"specification"`
+	StorageSpace             int      `json:"storage_space"`
+	TotalStorageSpace        int      `json:"total_storage_space"`
+	UsedStorageSpace         int      `json:"used_storage_space"`
+	PartitionNum             string   `json:"partition_num"`
+	ConnectAddress           string   `json:"connect_address"`
+	ManagementConnectAddress string   `json:"management_connect_address"`
+	Port                     int      `json:"port"`
+	Status                   string   `json:"status"`
+	Type                     string   `json:"type"`
+	ResourceSpecCode         string   `json:"resource_spec_code"`
+	ProductID                string   `json:"product_id"`
+	StorageSpecCode          string   `json:"storage_spec_code"`
+	VpcID                    string   `json:"vpc_id"`
+	SubnetID                 string   `json:"subnet_id"`
+	SecurityGroupID          string   `json:"security_group_id"`
+	AvailableZones           []string `json:"available_zones"`
+	AccessUser               string   `json:"access_user"`
+	KafkaManagerUser         string   `json:"kafka_manager_user"`
+	SslEnable                bool     `json:"ssl_enable"`
+	RetentionPolicy          string   `json:"retention_policy"`
+	MaintainBegin            string   `json:"maintain_begin"`
+	MaintainEnd              string   `json:"maintain_end"`
+	CreatedAt                string   `json:"created_at"`
+}
+
+// KafkaTopic describes a topic of DMS for Kafka instance
+type KafkaTopic struct {
+	Name             string `json:"name"`
+	Partition        int    `json:"partition"`
+	Replication      int    `json:"replication"`
+	RetentionTime    int    `json:"retention_time"`
+	SyncReplication  bool   `json:"sync_replication"`
+	SyncMessageFlush bool   `json:"sync_message_flush"`
+}
+
+// KafkaUser describes a SASL user of DMS for Kafka instance
+type KafkaUser struct {
+	Name        string `json:"user_name"`
+	Role        string `json:"role"`
+	DefaultApp  bool   `json:"default_app"`
+	CreatedTime int64  `json:"created_time"`
+}
+
+// KafkaTopicPolicy describes access of a user to a topic
+type KafkaTopicPolicy struct {
+	UserName     string `json:"user_name"`
+	AccessPolicy string `json:"access_policy"`
+	Owner        bool   `json:"owner,omitempty"`
+}
+
+func kafkaNotFound(format string, args ...interface{}) error {
+	return golangsdk.ErrDefault404{
+		ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+			Body: []byte(fmt.Sprintf(format, args...)),
+		},
+	}
+}
+
+// parseKafkaChildID splits ID of topic or user in format `<instance_id>/<name>`
+func parseKafkaChildID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, must be <instance_id>/<name>: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func createKafkaInstance(client *golangsdk.ServiceClient, opts map[string]interface{}) (string, error) {
+	var res struct {
+		InstanceID string `json:"instance_id"`
+	}
+	_, err := client.Post(client.ServiceURL("instances"), opts, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.InstanceID, nil
+}
+
+func getKafkaInstance(client *golangsdk.ServiceClient, instanceID string) (*KafkaInstance, error) {
+	var instance KafkaInstance
+	_, err := client.Get(client.ServiceURL("instances", instanceID), &instance, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &instance, nil
+}
+
+func updateKafkaInstance(client *golangsdk.ServiceClient, instanceID string, opts map[string]interface{}) error {
+	_, err := client.Put(client.ServiceURL("instances", instanceID), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// resizeKafkaInstance changes product (bandwidth) and storage space of the instance
+func resizeKafkaInstance(client *golangsdk.ServiceClient, instanceID, specCode string, storageSpace int) error {
+	body := map[string]interface{}{
+		"new_spec_code":     specCode,
+		"new_storage_space": storageSpace,
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "extend"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	return err
+}
+
+func deleteKafkaInstance(client *golangsdk.ServiceClient, instanceID string) error {
+	_, err := client.Delete(client.ServiceURL("instances", instanceID), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func createKafkaTopic(client *golangsdk.ServiceClient, instanceID string, topic KafkaTopic) error {
+	body := map[string]interface{}{
+		"id":                 topic.Name,
+		"partition":          topic.Partition,
+		"replication":        topic.Replication,
+		"retention_time":     topic.RetentionTime,
+		"sync_replication":   topic.SyncReplication,
+		"sync_message_flush": topic.SyncMessageFlush,
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "topics"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return err
+}
+
+// listKafkaTopics returns all topics of the instance, fetching them page by page
+func listKafkaTopics(client *golangsdk.ServiceClient, instanceID string) ([]KafkaTopic, error) {
+	const limit = 50
+	var topics []KafkaTopic
+	for offset := 0; ; offset += limit {
+		var res struct {
+			Total  int          `json:"total"`
+			Topics []KafkaTopic `json:"topics"`
+		}
+		url := fmt.Sprintf("%s?offset=%d&limit=%d", client.ServiceURL("instances", instanceID, "topics"), offset, limit)
+		_, err := client.Get(url, &res, nil)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, res.Topics...)
+		if len(res.Topics) == 0 || len(topics) >= res.Total {
+			return topics, nil
+		}
+	}
+}
+
+func getKafkaTopic(client *golangsdk.ServiceClient, instanceID, name string) (*KafkaTopic, error) {
+	topics, err := listKafkaTopics(client, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, topic := range topics {
+		if topic.Name == name {
+			return &topic, nil
+		}
+	}
+	return nil, kafkaNotFound("topic %s of DMS instance %s not found", name, instanceID)
+}
+
+// updateKafkaTopic changes topic settings, partitions can only be added
+func updateKafkaTopic(client *golangsdk.ServiceClient, instanceID string, topic KafkaTopic, newPartitions int) error {
+	topicOpts := map[string]interface{}{
+		"id":                 topic.Name,
+		"retention_time":     topic.RetentionTime,
+		"sync_replication":   topic.SyncReplication,
+		"sync_message_flush": topic.SyncMessageFlush,
+	}
+	if newPartitions > 0 {
+		topicOpts["new_partition_numbers"] = newPartitions
+	}
+	body := map[string]interface{}{
+		"topics": []interface{}{topicOpts},
+	}
+	_, err := client.Put(client.ServiceURL("instances", instanceID, "topics"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func deleteKafkaTopic(client *golangsdk.ServiceClient, instanceID, name string) error {
+	body := map[string]interface{}{
+		"topics": []string{name},
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "topics", "delete"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func createKafkaUser(client *golangsdk.ServiceClient, instanceID, name, password string) error {
+	body := map[string]interface{}{
+		"user_name":   name,
+		"user_passwd": password,
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "users"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func getKafkaUser(client *golangsdk.ServiceClient, instanceID, name string) (*KafkaUser, error) {
+	var res struct {
+		Users []KafkaUser `json:"users"`
+	}
+	_, err := client.Get(client.ServiceURL("instances", instanceID, "users"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range res.Users {
+		if user.Name == name {
+			return &user, nil
+		}
+	}
+	return nil, kafkaNotFound("user %s of DMS instance %s not found", name, instanceID)
+}
+
+func resetKafkaUserPassword(client *golangsdk.ServiceClient, instanceID, name, password string) error {
+	body := map[string]interface{}{
+		"new_password": password,
+	}
+	_, err := client.Put(client.ServiceURL("instances", instanceID, "users", name), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func deleteKafkaUser(client *golangsdk.ServiceClient, instanceID, name string) error {
+	body := map[string]interface{}{
+		"action": "delete",
+		"users":  []string{name},
+	}
+	_, err := client.Put(client.ServiceURL("instances", instanceID, "users"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+func getKafkaTopicPolicies(client *golangsdk.ServiceClient, instanceID, topic string) ([]KafkaTopicPolicy, error) {
+	var res struct {
+		Policies []KafkaTopicPolicy `json:"policies"`
+	}
+	_, err := client.Get(client.ServiceURL("instances", instanceID, "topics", topic, "accesspolicy"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Policies, nil
+}
+
+// setKafkaTopicPolicy grants access to the topic for the user, `none` access policy revokes it
+func setKafkaTopicPolicy(client *golangsdk.ServiceClient, instanceID, topic, userName, accessPolicy string) error {
+	body := map[string]interface{}{
+		"topics": []interface{}{
+			map[string]interface{}{
+				"name": topic,
+				"policies": []interface{}{
+					map[string]interface{}{
+						"user_name":     userName,
+						"access_policy": accessPolicy,
+					},
+				},
+			},
+		},
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "topics", "accesspolicy"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
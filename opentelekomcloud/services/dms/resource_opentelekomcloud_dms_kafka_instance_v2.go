@@ -0,0 +1,387 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDmsKafkaInstanceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaInstanceV2Create,
+		Read:   resourceDmsKafkaInstanceV2Read,
+		Update: resourceDmsKafkaInstanceV2Update,
+		Delete: resourceDmsKafkaInstanceV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		CustomizeDiff: validateKafkaStorageChange,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "2.3.0",
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"specification": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"storage_space": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"storage_spec_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"dms.physical.storage.high", "dms.physical.storage.ultra",
+				}, false),
+			},
+			"partition_num": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"available_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"access_user": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"password"},
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"access_user"},
+			},
+			"manager_user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"manager_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"maintain_begin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"maintain_end": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"retention_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"produce_reject", "time_base",
+				}, false),
+			},
+			"ssl_enable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_spec_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connect_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manager_connect_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_storage_space": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateKafkaStorageChange rejects storage decrease, only extension is supported in place
+func validateKafkaStorageChange(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("storage_space") {
+		return nil
+	}
+	oldSize, newSize := d.GetChange("storage_space")
+	if newSize.(int) < oldSize.(int) {
+		return fmt.Errorf("storage_space of DMS Kafka instance can't be decreased: %d -> %d", oldSize, newSize)
+	}
+	return nil
+}
+
+func resourceDmsKafkaInstanceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"name":                   d.Get("name").(string),
+		"description":            d.Get("description").(string),
+		"engine":                 "kafka",
+		"engine_version":         d.Get("engine_version").(string),
+		"product_id":             d.Get("product_id").(string),
+		"storage_space":          d.Get("storage_space").(int),
+		"storage_spec_code":      d.Get("storage_spec_code").(string),
+		"vpc_id":                 d.Get("vpc_id").(string),
+		"subnet_id":              d.Get("subnet_id").(string),
+		"security_group_id":      d.Get("security_group_id").(string),
+		"available_zones":        common.GetAllAvailableZones(d),
+		"kafka_manager_user":     d.Get("manager_user").(string),
+		"kafka_manager_password": d.Get("manager_password").(string),
+		"ssl_enable":             d.Get("access_user").(string) != "",
+	}
+	for _, key := range []string{"specification", "access_user", "password", "maintain_begin", "maintain_end", "retention_policy"} {
+		if v, ok := d.GetOk(key); ok {
+			createOpts[key] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("partition_num"); ok {
+		createOpts["partition_num"] = strconv.Itoa(v.(int))
+	}
+
+	instanceID, err := createKafkaInstance(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating DMS Kafka instance: %w", err)
+	}
+	log.Printf("[INFO] DMS Kafka instance ID: %s", instanceID)
+
+	d.SetId(instanceID)
+
+	if err := waitForKafkaInstanceRunning(client, instanceID, []string{"CREATING"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DMS Kafka instance (%s) to become ready: %w", instanceID, err)
+	}
+
+	return resourceDmsKafkaInstanceV2Read(d, meta)
+}
+
+func resourceDmsKafkaInstanceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	instance, err := getKafkaInstance(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "DMS Kafka instance")
+	}
+	log.Printf("[DEBUG] DMS Kafka instance %s: %+v", d.Id(), instance)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", instance.Name),
+		d.Set("description", instance.Description),
+		d.Set("engine_version", instance.EngineVersion),
+		d.Set("product_id", instance.ProductID),
+		d.Set("specification", instance.Specification),
+		d.Set("storage_space", instance.TotalStorageSpace),
+		d.Set("storage_spec_code", instance.StorageSpecCode),
+		d.Set("vpc_id", instance.VpcID),
+		d.Set("subnet_id", instance.SubnetID),
+		d.Set("security_group_id", instance.SecurityGroupID),
+		d.Set("available_zones", instance.AvailableZones),
+		d.Set("manager_user", instance.KafkaManagerUser),
+		d.Set("maintain_begin", instance.MaintainBegin),
+		d.Set("maintain_end", instance.MaintainEnd),
+		d.Set("retention_policy", instance.RetentionPolicy),
+		d.Set("ssl_enable", instance.SslEnable),
+		d.Set("status", instance.Status),
+		d.Set("type", instance.Type),
+		d.Set("resource_spec_code", instance.ResourceSpecCode),
+		d.Set("connect_address", instance.ConnectAddress),
+		d.Set("manager_connect_address", instance.ManagementConnectAddress),
+		d.Set("port", instance.Port),
+		d.Set("used_storage_space", instance.UsedStorageSpace),
+		d.Set("created_at", instance.CreatedAt),
+	)
+	if instance.AccessUser != "" {
+		mErr = multierror.Append(mErr, d.Set("access_user", instance.AccessUser))
+	}
+	if partitionNum, err := strconv.Atoi(instance.PartitionNum); err == nil {
+		mErr = multierror.Append(mErr, d.Set("partition_num", partitionNum))
+	}
+	return mErr.ErrorOrNil()
+}
+
+func resourceDmsKafkaInstanceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	updateOpts := make(map[string]interface{})
+	for _, key := range []string{"name", "description", "security_group_id", "maintain_begin", "maintain_end", "retention_policy"} {
+		if d.HasChange(key) {
+			updateOpts[key] = d.Get(key).(string)
+		}
+	}
+	if len(updateOpts) > 0 {
+		log.Printf("[DEBUG] DMS Kafka instance update options: %#v", updateOpts)
+		if err := updateKafkaInstance(client, d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("error updating DMS Kafka instance: %w", err)
+		}
+	}
+
+	if d.HasChanges("product_id", "storage_space") {
+		productID := d.Get("product_id").(string)
+		storageSpace := d.Get("storage_space").(int)
+		if err := resizeKafkaInstance(client, d.Id(), productID, storageSpace); err != nil {
+			return fmt.Errorf("error resizing DMS Kafka instance: %w", err)
+		}
+		if err := waitForKafkaInstanceRunning(client, d.Id(), []string{"EXTENDING"}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for DMS Kafka instance (%s) to be resized: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDmsKafkaInstanceV2Read(d, meta)
+}
+
+func resourceDmsKafkaInstanceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	if err := deleteKafkaInstance(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "error deleting DMS Kafka instance")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING", "RUNNING"},
+		Target:     []string{"DELETED"},
+		Refresh:    dmsKafkaInstanceV2StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DMS Kafka instance (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForKafkaInstanceRunning(client *golangsdk.ServiceClient, instanceID string, pending []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{"RUNNING"},
+		Refresh:    dmsKafkaInstanceV2StateRefreshFunc(client, instanceID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func dmsKafkaInstanceV2StateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := getKafkaInstance(client, instanceID)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return instance, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		if instance.Status == "ERROR" || instance.Status == "CREATEFAILED" {
+			return instance, instance.Status, fmt.Errorf("DMS Kafka instance is in %s state", instance.Status)
+		}
+		return instance, instance.Status, nil
+	}
+}
@@ -0,0 +1,200 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDmsKafkaTopicV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaTopicV2Create,
+		Read:   resourceDmsKafkaTopicV2Read,
+		Update: resourceDmsKafkaTopicV2Update,
+		Delete: resourceDmsKafkaTopicV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDmsKafkaTopicV2Import,
+		},
+
+		CustomizeDiff: forceNewOnPartitionDecrease,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 200),
+			},
+			"partitions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"replicas": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 3),
+			},
+			"retention_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      72,
+				ValidateFunc: validation.IntBetween(1, 168),
+			},
+			"sync_replication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"sync_message_flush": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// forceNewOnPartitionDecrease recreates the topic when partitions are decreased,
+// partitions can only be added in place
+func forceNewOnPartitionDecrease(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("partitions") {
+		return nil
+	}
+	oldNum, newNum := d.GetChange("partitions")
+	if newNum.(int) < oldNum.(int) {
+		return d.ForceNew("partitions")
+	}
+	return nil
+}
+
+func kafkaTopicFromSchema(d *schema.ResourceData) KafkaTopic {
+	return KafkaTopic{
+		Name:             d.Get("name").(string),
+		Partition:        d.Get("partitions").(int),
+		Replication:      d.Get("replicas").(int),
+		RetentionTime:    d.Get("retention_time").(int),
+		SyncReplication:  d.Get("sync_replication").(bool),
+		SyncMessageFlush: d.Get("sync_message_flush").(bool),
+	}
+}
+
+func resourceDmsKafkaTopicV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	topic := kafkaTopicFromSchema(d)
+	log.Printf("[DEBUG] DMS Kafka topic create options: %#v", topic)
+
+	if err := createKafkaTopic(client, instanceID, topic); err != nil {
+		return fmt.Errorf("error creating DMS Kafka topic: %w", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, topic.Name))
+
+	return resourceDmsKafkaTopicV2Read(d, meta)
+}
+
+func resourceDmsKafkaTopicV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	instanceID, name, err := parseKafkaChildID(d.Id())
+	if err != nil {
+		return err
+	}
+	topic, err := getKafkaTopic(client, instanceID, name)
+	if err != nil {
+		return common.CheckDeleted(d, err, "DMS Kafka topic")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", instanceID),
+		d.Set("name", topic.Name),
+		d.Set("partitions", topic.Partition),
+		d.Set("replicas", topic.Replication),
+		d.Set("retention_time", topic.RetentionTime),
+		d.Set("sync_replication", topic.SyncReplication),
+		d.Set("sync_message_flush", topic.SyncMessageFlush),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceDmsKafkaTopicV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	instanceID, _, err := parseKafkaChildID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	newPartitions := 0
+	if d.HasChange("partitions") {
+		newPartitions = d.Get("partitions").(int)
+	}
+	if err := updateKafkaTopic(client, instanceID, kafkaTopicFromSchema(d), newPartitions); err != nil {
+		return fmt.Errorf("error updating DMS Kafka topic: %w", err)
+	}
+
+	return resourceDmsKafkaTopicV2Read(d, meta)
+}
+
+func resourceDmsKafkaTopicV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	instanceID, name, err := parseKafkaChildID(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := deleteKafkaTopic(client, instanceID, name); err != nil {
+		return common.CheckDeleted(d, err, "error deleting DMS Kafka topic")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDmsKafkaTopicV2Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	instanceID, _, err := parseKafkaChildID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("instance_id", instanceID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,239 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDmsKafkaUserV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaUserV2Create,
+		Read:   resourceDmsKafkaUserV2Read,
+		Update: resourceDmsKafkaUserV2Update,
+		Delete: resourceDmsKafkaUserV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDmsKafkaUserV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"topic_access": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"access_policy": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"all", "pub", "sub",
+							}, false),
+						},
+					},
+				},
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_app": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDmsKafkaUserV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+	policyClient, err := config.DmsKafkaV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMS Kafka v1 client: %w", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	if err := createKafkaUser(client, instanceID, name, d.Get("password").(string)); err != nil {
+		return fmt.Errorf("error creating DMS Kafka user: %w", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, name))
+
+	if err := setKafkaUserTopicAccess(policyClient, instanceID, name, nil, d.Get("topic_access").(*schema.Set).List()); err != nil {
+		return err
+	}
+
+	return resourceDmsKafkaUserV2Read(d, meta)
+}
+
+func resourceDmsKafkaUserV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+	policyClient, err := config.DmsKafkaV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMS Kafka v1 client: %w", err)
+	}
+
+	instanceID, name, err := parseKafkaChildID(d.Id())
+	if err != nil {
+		return err
+	}
+	user, err := getKafkaUser(client, instanceID, name)
+	if err != nil {
+		return common.CheckDeleted(d, err, "DMS Kafka user")
+	}
+
+	topics, err := listKafkaTopics(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error listing DMS Kafka topics: %w", err)
+	}
+	var topicAccess []map[string]interface{}
+	for _, topic := range topics {
+		policies, err := getKafkaTopicPolicies(policyClient, instanceID, topic.Name)
+		if err != nil {
+			return fmt.Errorf("error fetching access policies of DMS Kafka topic %s: %w", topic.Name, err)
+		}
+		for _, policy := range policies {
+			if policy.UserName == user.Name && !policy.Owner {
+				topicAccess = append(topicAccess, map[string]interface{}{
+					"topic":         topic.Name,
+					"access_policy": policy.AccessPolicy,
+				})
+			}
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", instanceID),
+		d.Set("name", user.Name),
+		d.Set("role", user.Role),
+		d.Set("default_app", user.DefaultApp),
+		d.Set("topic_access", topicAccess),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceDmsKafkaUserV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+	policyClient, err := config.DmsKafkaV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMS Kafka v1 client: %w", err)
+	}
+
+	instanceID, name, err := parseKafkaChildID(d.Id())
+	if err != nil {
+		return err
+	}
+	if d.HasChange("password") {
+		if err := resetKafkaUserPassword(client, instanceID, name, d.Get("password").(string)); err != nil {
+			return fmt.Errorf("error resetting DMS Kafka user password: %w", err)
+		}
+	}
+
+	if d.HasChange("topic_access") {
+		oldAccess, newAccess := d.GetChange("topic_access")
+		err := setKafkaUserTopicAccess(policyClient, instanceID, name,
+			oldAccess.(*schema.Set).List(), newAccess.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceDmsKafkaUserV2Read(d, meta)
+}
+
+func resourceDmsKafkaUserV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %w", err)
+	}
+
+	instanceID, name, err := parseKafkaChildID(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := deleteKafkaUser(client, instanceID, name); err != nil {
+		return common.CheckDeleted(d, err, "error deleting DMS Kafka user")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// setKafkaUserTopicAccess revokes access to topics missing in newAccess and grants the rest
+func setKafkaUserTopicAccess(client *golangsdk.ServiceClient, instanceID, userName string, oldAccess, newAccess []interface{}) error {
+	policies := make(map[string]string)
+	for _, v := range oldAccess {
+		access := v.(map[string]interface{})
+		policies[access["topic"].(string)] = "none"
+	}
+	for _, v := range newAccess {
+		access := v.(map[string]interface{})
+		policies[access["topic"].(string)] = access["access_policy"].(string)
+	}
+
+	for topic, policy := range policies {
+		log.Printf("[DEBUG] Setting %s access of DMS Kafka user %s to topic %s", policy, userName, topic)
+		if err := setKafkaTopicPolicy(client, instanceID, topic, userName, policy); err != nil {
+			return fmt.Errorf("error setting access of DMS Kafka user %s to topic %s: %w", userName, topic, err)
+		}
+	}
+	return nil
+}
+
+func resourceDmsKafkaUserV2Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	instanceID, _, err := parseKafkaChildID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("instance_id", instanceID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,98 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// v21URL builds URL of DNS v2.1 API, which supports line-based and weighted record sets,
+// the client is created for v2 API
+func v21URL(client *golangsdk.ServiceClient, parts ...string) string {
+	base := strings.Replace(client.ResourceBaseURL(), "/v2/", "/v2.1/", 1)
+	return base + strings.Join(parts, "/")
+}
+
+type recordSetV21Opts struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	TTL         int      `json:"ttl,omitempty"`
+	Records     []string `json:"records"`
+	Line        string   `json:"line,omitempty"`
+	Weight      *int     `json:"weight,omitempty"`
+}
+
+type recordSetV21 struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	Line   string `json:"line"`
+	Weight *int   `json:"weight"`
+}
+
+func createRecordSetV21(client *golangsdk.ServiceClient, zoneID string, opts recordSetV21Opts) (*recordSetV21, error) {
+	result := new(recordSetV21)
+	_, err := client.Post(v21URL(client, "zones", zoneID, "recordsets"), opts, result, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return result, err
+}
+
+func getRecordSetV21(client *golangsdk.ServiceClient, zoneID, id string) (*recordSetV21, error) {
+	result := new(recordSetV21)
+	_, err := client.Get(v21URL(client, "zones", zoneID, "recordsets", id), result, nil)
+	return result, err
+}
+
+func updateRecordSetV21(client *golangsdk.ServiceClient, zoneID, id string, opts recordSetV21Opts) error {
+	_, err := client.Put(v21URL(client, "zones", zoneID, "recordsets", id), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return err
+}
+
+// defaultLine is the resolution line of record sets not bound to a specific line
+const defaultLine = "default_view"
+
+// lineOrWeightedRecordSets returns IDs of the record sets bound to a specific line or sharing
+// name and type with other record sets, as weighted record sets do
+func lineOrWeightedRecordSets(sets []recordSetV21) map[string]bool {
+	count := make(map[string]int)
+	for _, set := range sets {
+		count[recordSetKey(set.Name, set.Type)]++
+	}
+	result := make(map[string]bool)
+	for _, set := range sets {
+		if count[recordSetKey(set.Name, set.Type)] > 1 || set.Line != "" && set.Line != defaultLine {
+			result[set.ID] = true
+		}
+	}
+	return result
+}
+
+type recordSetV21Page struct {
+	RecordSets []recordSetV21 `json:"recordsets"`
+	Metadata   struct {
+		TotalCount int `json:"total_count"`
+	} `json:"metadata"`
+}
+
+func listRecordSetsV21(client *golangsdk.ServiceClient, zoneID string) ([]recordSetV21, error) {
+	const limit = 500
+
+	var sets []recordSetV21
+	for {
+		page := new(recordSetV21Page)
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", v21URL(client, "zones", zoneID, "recordsets"), limit, len(sets))
+		if _, err := client.Get(url, page, nil); err != nil {
+			return nil, err
+		}
+		sets = append(sets, page.RecordSets...)
+		if len(page.RecordSets) < limit || len(sets) >= page.Metadata.TotalCount {
+			return sets, nil
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package dns
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceDNSZoneFileV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDNSZoneFileV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"include_soa_ns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"recordsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDNSZoneFileV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	zoneFile := d.Get("zone_file").(string)
+	origin := d.Get("origin").(string)
+	zoneID := d.Get("zone_id").(string)
+
+	// IDs of existing record sets in format `zone_id/recordset_id`
+	existingIDs := make(map[string]string)
+	if zoneID != "" {
+		dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+		}
+		zone, err := zones.Get(dnsClient, zoneID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving OpenTelekomCloud DNS zone: %s", err)
+		}
+		if origin == "" {
+			origin = zone.Name
+		}
+
+		existing, err := listZoneRecordSets(dnsClient, zone)
+		if err != nil {
+			return err
+		}
+		for _, set := range existing {
+			existingIDs[recordSetKey(set.Name, set.Type)] = fmt.Sprintf("%s/%s", zoneID, set.ID)
+		}
+	}
+
+	sets, err := parseZoneFile(zoneFile, origin)
+	if err != nil {
+		return fmt.Errorf("error parsing zone file: %s", err)
+	}
+
+	includeSOANS := d.Get("include_soa_ns").(bool)
+	var recordSets []map[string]interface{}
+	for _, set := range sets {
+		if !isManagedRecordSetType(set.Type, includeSOANS) {
+			continue
+		}
+		recordSets = append(recordSets, map[string]interface{}{
+			"id":      existingIDs[set.key()],
+			"name":    set.Name,
+			"type":    set.Type,
+			"ttl":     set.TTL,
+			"records": set.Records,
+		})
+	}
+	log.Printf("[DEBUG] Parsed %d DNS record sets from zone file", len(recordSets))
+
+	d.SetId(strconv.Itoa(hashcode.String(zoneID + zoneFile)))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("origin", fqdn(origin)),
+		d.Set("recordsets", recordSets),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting DNS zone file fields: %s", err)
+	}
+	return nil
+}
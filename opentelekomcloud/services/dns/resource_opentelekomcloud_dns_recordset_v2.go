@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
@@ -25,7 +26,7 @@ func ResourceDNSRecordSetV2() *schema.Resource {
 		Update: resourceDNSRecordSetV2Update,
 		Delete: resourceDNSRecordSetV2Delete,
 		Importer: &schema.ResourceImporter{
-			State: common.ImportAsManaged,
+			State: resourceDNSRecordSetV2Import,
 		},
 
 		CustomizeDiff: useSharedRecordSet,
@@ -82,6 +83,18 @@ func ResourceDNSRecordSetV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"line": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
 			"tags": common.TagsSchema(),
 
 			"shared": {
@@ -111,6 +124,39 @@ func getRecordSetCreateOpts(d cfg.SchemaOrDiff) RecordSetCreateOpts {
 	}
 }
 
+// recordSetConfig is implemented by both schema.ResourceData and schema.ResourceDiff
+type recordSetConfig interface {
+	GetOk(string) (interface{}, bool)
+	GetOkExists(string) (interface{}, bool)
+}
+
+// isLineOrWeighted checks if line-based or weighted record set is configured,
+// those are managed with DNS v2.1 API and can share name and type with other record sets
+func isLineOrWeighted(d recordSetConfig) bool {
+	_, line := d.GetOk("line")
+	// weight of `0` is a valid one, so GetOk can't be used here
+	_, weight := d.GetOkExists("weight")
+	return line || weight
+}
+
+func getRecordSetV21Opts(d *schema.ResourceData) recordSetV21Opts {
+	opts := getRecordSetCreateOpts(d)
+	v21Opts := recordSetV21Opts{
+		Name:        opts.Name,
+		Description: opts.Description,
+		Type:        opts.Type,
+		TTL:         opts.TTL,
+		Records:     opts.Records,
+		Line:        d.Get("line").(string),
+	}
+	// weight `0` disables resolution of the record set
+	if v, ok := d.GetOkExists("weight"); ok {
+		weight := v.(int)
+		v21Opts.Weight = &weight
+	}
+	return v21Opts
+}
+
 func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
@@ -128,19 +174,38 @@ func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) erro
 		return resourceDNSRecordSetV2Read(d, meta)
 	}
 
-	createOpts := getRecordSetCreateOpts(d)
+	var recordSetID string
+	if isLineOrWeighted(d) {
+		resourceType, err := getDNSRecordSetResourceType(dnsClient, zoneID)
+		if err != nil {
+			return fmt.Errorf("error getting resource type of DNS record set: %s", err)
+		}
+		if resourceType != "DNS-public_recordset" {
+			return fmt.Errorf("`line` and `weight` are supported only for record sets of public zones")
+		}
 
-	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	recordSet, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("error creating OpenTelekomCloud DNS record set: %s", err)
+		createOpts := getRecordSetV21Opts(d)
+		log.Printf("[DEBUG] Create Options: %#v", createOpts)
+		recordSet, err := createRecordSetV21(dnsClient, zoneID, createOpts)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DNS record set: %s", err)
+		}
+		recordSetID = recordSet.ID
+	} else {
+		createOpts := getRecordSetCreateOpts(d)
+		log.Printf("[DEBUG] Create Options: %#v", createOpts)
+		recordSet, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DNS record set: %s", err)
+		}
+		recordSetID = recordSet.ID
 	}
 
-	log.Printf("[DEBUG] Waiting for DNS record set (%s) to become available", recordSet.ID)
+	log.Printf("[DEBUG] Waiting for DNS record set (%s) to become available", recordSetID)
 	stateConf := &resource.StateChangeConf{
 		Target:     []string{"ACTIVE"},
 		Pending:    []string{"PENDING"},
-		Refresh:    waitForDNSRecordSet(dnsClient, zoneID, recordSet.ID),
+		Refresh:    waitForDNSRecordSet(dnsClient, zoneID, recordSetID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -150,10 +215,10 @@ func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return fmt.Errorf(
 			"error waiting for record set (%s) to become ACTIVE for creation: %s",
-			recordSet.ID, err)
+			recordSetID, err)
 	}
 
-	id := fmt.Sprintf("%s/%s", zoneID, recordSet.ID)
+	id := fmt.Sprintf("%s/%s", zoneID, recordSetID)
 	d.SetId(id)
 
 	// set tags
@@ -161,16 +226,16 @@ func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) erro
 	if len(tagRaw) > 0 {
 		resourceType, err := getDNSRecordSetResourceType(dnsClient, zoneID)
 		if err != nil {
-			return fmt.Errorf("error getting resource type of DNS record set %s: %s", recordSet.ID, err)
+			return fmt.Errorf("error getting resource type of DNS record set %s: %s", recordSetID, err)
 		}
 
 		tagList := common.ExpandResourceTags(tagRaw)
-		if tagErr := tags.Create(dnsClient, resourceType, recordSet.ID, tagList).ExtractErr(); tagErr != nil {
-			return fmt.Errorf("error setting tags of DNS record set %s: %s", recordSet.ID, tagErr)
+		if tagErr := tags.Create(dnsClient, resourceType, recordSetID, tagList).ExtractErr(); tagErr != nil {
+			return fmt.Errorf("error setting tags of DNS record set %s: %s", recordSetID, tagErr)
 		}
 	}
 
-	log.Printf("[DEBUG] Created OpenTelekomCloud DNS record set %s: %#v", recordSet.ID, recordSet)
+	log.Printf("[DEBUG] Created OpenTelekomCloud DNS record set %s", recordSetID)
 	return resourceDNSRecordSetV2Read(d, meta)
 }
 
@@ -223,9 +288,69 @@ func resourceDNSRecordSetV2Read(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error saving tags for OpenTelekomCloud DNS record set %s: %s", recordsetID, err)
 	}
 
+	// line and weight are available only for public zones
+	if resourceType == "DNS-public_recordset" && isLineOrWeighted(d) {
+		v21, err := getRecordSetV21(dnsClient, zoneID, recordsetID)
+		if err != nil {
+			return fmt.Errorf("error fetching line and weight of OpenTelekomCloud DNS record set %s: %s", recordsetID, err)
+		}
+		if err := setRecordSetV21Attributes(d, v21); err != nil {
+			return fmt.Errorf("error saving line and weight for OpenTelekomCloud DNS record set %s: %s", recordsetID, err)
+		}
+	}
+
 	return nil
 }
 
+func setRecordSetV21Attributes(d *schema.ResourceData, v21 *recordSetV21) error {
+	weight := 0
+	if v21.Weight != nil {
+		weight = *v21.Weight
+	}
+	mErr := multierror.Append(
+		d.Set("line", v21.Line),
+		d.Set("weight", weight),
+	)
+	return mErr.ErrorOrNil()
+}
+
+// resourceDNSRecordSetV2Import imports record set as a managed one, line and weight of
+// the record sets of public zones are imported if those are line-based or weighted
+func resourceDNSRecordSetV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*cfg.Config)
+	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	zoneID, recordsetID, err := ParseDNSV2RecordSetID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	resourceType, err := getDNSRecordSetResourceType(dnsClient, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource type of DNS record set %s: %s", recordsetID, err)
+	}
+	if resourceType == "DNS-public_recordset" {
+		v21Sets, err := listRecordSetsV21(dnsClient, zoneID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing lines and weights of OpenTelekomCloud DNS record sets: %s", err)
+		}
+		if lineOrWeightedRecordSets(v21Sets)[recordsetID] {
+			for i := range v21Sets {
+				if v21Sets[i].ID != recordsetID {
+					continue
+				}
+				if err := setRecordSetV21Attributes(d, &v21Sets[i]); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return common.ImportAsManaged(d, meta)
+}
+
 func resourceDNSRecordSetV2Update(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
@@ -256,11 +381,28 @@ func resourceDNSRecordSetV2Update(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	log.Printf("[DEBUG] Updating  record set %s with options: %#v", recordsetID, updateOpts)
-
-	_, err = recordsets.Update(dnsClient, zoneID, recordsetID, updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("error updating OpenTelekomCloud DNS  record set: %s", err)
+	if isLineOrWeighted(d) {
+		v21Opts := recordSetV21Opts{
+			Name:        d.Get("name").(string),
+			Description: updateOpts.Description,
+			Type:        d.Get("type").(string),
+			TTL:         updateOpts.TTL,
+			Records:     updateOpts.Records,
+		}
+		if d.HasChange("weight") {
+			weight := d.Get("weight").(int)
+			v21Opts.Weight = &weight
+		}
+		log.Printf("[DEBUG] Updating  record set %s with options: %#v", recordsetID, v21Opts)
+		if err := updateRecordSetV21(dnsClient, zoneID, recordsetID, v21Opts); err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud DNS  record set: %s", err)
+		}
+	} else {
+		log.Printf("[DEBUG] Updating  record set %s with options: %#v", recordsetID, updateOpts)
+		_, err = recordsets.Update(dnsClient, zoneID, recordsetID, updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud DNS  record set: %s", err)
+		}
 	}
 
 	log.Printf("[DEBUG] Waiting for DNS record set (%s) to update", recordsetID)
@@ -440,6 +582,12 @@ func useSharedRecordSet(d *schema.ResourceDiff, meta interface{}) (err error) {
 		return
 	}
 
+	// line-based and weighted record sets coexist with others having the same name and type
+	if isLineOrWeighted(d) {
+		_ = d.SetNew("shared", false)
+		return
+	}
+
 	id, err := getExistingRecordSetID(d, meta)
 	if id == "" {
 		_ = d.SetNew("shared", false)
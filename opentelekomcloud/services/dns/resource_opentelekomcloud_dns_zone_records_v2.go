@@ -0,0 +1,512 @@
+package dns
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDNSZoneRecordsV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSZoneRecordsV2Create,
+		Read:   resourceDNSZoneRecordsV2Read,
+		Update: resourceDNSZoneRecordsV2Update,
+		Delete: resourceDNSZoneRecordsV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDNSZoneRecordsV2Import,
+		},
+
+		CustomizeDiff: parseZoneFileDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"zone_file", "recordset"},
+			},
+			"recordset": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"zone_file", "recordset"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  defaultZoneFileTTL,
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+				Set: resourceDNSZoneRecordSetHash,
+			},
+			"include_soa_ns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"recordset_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceDNSZoneRecordSetHash hashes normalized record set, so `www.example.com` and `www.example.com.` are equal
+func resourceDNSZoneRecordSetHash(v interface{}) int {
+	set := expandZoneRecordSet(v.(map[string]interface{}))
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", set.Name))
+	buf.WriteString(fmt.Sprintf("%s-", set.Type))
+	buf.WriteString(fmt.Sprintf("%d-", set.TTL))
+	// records are length-prefixed, as any separator can be a part of the record data
+	for _, record := range set.Records {
+		buf.WriteString(fmt.Sprintf("%d:%s-", len(record), record))
+	}
+	return hashcode.String(buf.String())
+}
+
+// equalRecords compares sorted records
+func equalRecords(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func expandZoneRecordSet(raw map[string]interface{}) zoneRecordSet {
+	var records []string
+	switch v := raw["records"].(type) {
+	case *schema.Set:
+		for _, record := range v.List() {
+			records = append(records, record.(string))
+		}
+	case []interface{}:
+		for _, record := range v {
+			records = append(records, record.(string))
+		}
+	case []string:
+		records = append(records, v...)
+	}
+	sort.Strings(records)
+
+	ttl, _ := raw["ttl"].(int)
+	return zoneRecordSet{
+		Name:    fqdn(raw["name"].(string)),
+		Type:    strings.ToUpper(raw["type"].(string)),
+		TTL:     ttl,
+		Records: records,
+	}
+}
+
+func flattenZoneRecordSets(sets []zoneRecordSet) []interface{} {
+	result := make([]interface{}, len(sets))
+	for i, set := range sets {
+		result[i] = map[string]interface{}{
+			"name":    set.Name,
+			"type":    set.Type,
+			"ttl":     set.TTL,
+			"records": set.Records,
+		}
+	}
+	return result
+}
+
+// isManagedRecordSetType reports if record sets of the type are reconciled,
+// SOA and NS record sets are managed by the DNS service unless `include_soa_ns` is set
+func isManagedRecordSetType(recordType string, includeSOANS bool) bool {
+	return includeSOANS || recordType != "SOA" && recordType != "NS"
+}
+
+// isZoneApexRecordSet reports if record set is SOA or NS record set of the zone itself,
+// such record sets are created with the zone and can't be created or deleted
+func isZoneApexRecordSet(set recordsets.RecordSet, zoneName string) bool {
+	return set.Name == zoneName && (set.Type == "SOA" || set.Type == "NS")
+}
+
+func getDesiredZoneRecordSets(d cfg.SchemaOrDiff, zoneName string) ([]zoneRecordSet, error) {
+	includeSOANS := d.Get("include_soa_ns").(bool)
+
+	var sets []zoneRecordSet
+	if zoneFile := d.Get("zone_file").(string); zoneFile != "" {
+		parsed, err := parseZoneFile(zoneFile, zoneName)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing zone file: %s", err)
+		}
+		sets = parsed
+	} else {
+		for _, raw := range d.Get("recordset").(*schema.Set).List() {
+			sets = append(sets, expandZoneRecordSet(raw.(map[string]interface{})))
+		}
+	}
+
+	var managed []zoneRecordSet
+	seen := make(map[string]bool)
+	for _, set := range sets {
+		if !isManagedRecordSetType(set.Type, includeSOANS) {
+			continue
+		}
+		if seen[set.key()] {
+			return nil, fmt.Errorf("duplicate record set %s", set.key())
+		}
+		seen[set.key()] = true
+		managed = append(managed, set)
+	}
+	sortZoneRecordSets(managed)
+	return managed, nil
+}
+
+// listZoneRecordSets lists record sets of the zone, except line-based and weighted ones:
+// those can share name and type with other record sets and are managed by `opentelekomcloud_dns_recordset_v2`
+func listZoneRecordSets(client *golangsdk.ServiceClient, zone *zones.Zone) ([]recordsets.RecordSet, error) {
+	allPages, err := recordsets.ListByZone(client, zone.ID, nil).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing record sets: %s", err)
+	}
+	sets, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting record sets: %s", err)
+	}
+
+	// line and weight are available only for public zones
+	if zone.ZoneType != "public" {
+		return sets, nil
+	}
+	v21Sets, err := listRecordSetsV21(client, zone.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing record sets lines and weights: %s", err)
+	}
+	skipped := lineOrWeightedRecordSets(v21Sets)
+	var result []recordsets.RecordSet
+	for _, set := range sets {
+		if skipped[set.ID] {
+			log.Printf("[DEBUG] Skipping line-based or weighted record set %s (%s)", set.ID, recordSetKey(set.Name, set.Type))
+			continue
+		}
+		result = append(result, set)
+	}
+	return result, nil
+}
+
+// parseZoneFileDiff sets `recordset` from `zone_file`, so changes of single records are shown in the plan,
+// relative names are completed with `zone_name` known after the creation only
+func parseZoneFileDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("zone_file").(string) == "" && d.NewValueKnown("zone_file") {
+		return nil
+	}
+	zoneName := d.Get("zone_name").(string)
+	if !d.NewValueKnown("zone_file") || zoneName == "" {
+		return d.SetNewComputed("recordset")
+	}
+
+	sets, err := getDesiredZoneRecordSets(d, zoneName)
+	if err != nil {
+		return err
+	}
+	return d.SetNew("recordset", flattenZoneRecordSets(sets))
+}
+
+// applyZoneRecordSets creates, updates and deletes record sets of the zone to match desired ones
+func applyZoneRecordSets(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) error {
+	zoneID := d.Get("zone_id").(string)
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenTelekomCloud DNS zone: %s", err)
+	}
+
+	desired, err := getDesiredZoneRecordSets(d, zone.Name)
+	if err != nil {
+		return err
+	}
+	existing, err := listZoneRecordSets(client, zone)
+	if err != nil {
+		return err
+	}
+
+	includeSOANS := d.Get("include_soa_ns").(bool)
+	existingByKey := make(map[string]recordsets.RecordSet)
+	for _, set := range existing {
+		key := recordSetKey(set.Name, set.Type)
+		if _, ok := existingByKey[key]; ok {
+			return fmt.Errorf("multiple record sets %s exist in the zone, those can be managed with `opentelekomcloud_dns_recordset_v2` only", key)
+		}
+		existingByKey[key] = set
+	}
+
+	var pending, deleted []string
+	desiredKeys := make(map[string]bool)
+	for _, set := range desired {
+		desiredKeys[set.key()] = true
+
+		current, ok := existingByKey[set.key()]
+		if !ok {
+			createOpts := recordsets.CreateOpts{
+				Name:    set.Name,
+				Type:    set.Type,
+				TTL:     set.TTL,
+				Records: set.Records,
+			}
+			log.Printf("[DEBUG] Create Options: %#v", createOpts)
+			created, err := recordsets.Create(client, zoneID, createOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("error creating OpenTelekomCloud DNS record set %s: %s", set.key(), err)
+			}
+			pending = append(pending, created.ID)
+			continue
+		}
+
+		records := append([]string{}, current.Records...)
+		sort.Strings(records)
+		if current.TTL == set.TTL && equalRecords(records, set.Records) {
+			continue
+		}
+		updateOpts := recordsets.UpdateOpts{
+			TTL:     set.TTL,
+			Records: set.Records,
+		}
+		log.Printf("[DEBUG] Updating record set %s with options: %#v", current.ID, updateOpts)
+		if err := recordsets.Update(client, zoneID, current.ID, updateOpts).Err; err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud DNS record set %s: %s", set.key(), err)
+		}
+		pending = append(pending, current.ID)
+	}
+
+	for _, set := range existing {
+		key := recordSetKey(set.Name, set.Type)
+		if desiredKeys[key] || !isManagedRecordSetType(set.Type, includeSOANS) || isZoneApexRecordSet(set, zone.Name) {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting record set %s not present in the configuration", key)
+		if err := recordsets.Delete(client, zoneID, set.ID).ExtractErr(); err != nil {
+			return fmt.Errorf("error deleting OpenTelekomCloud DNS record set %s: %s", key, err)
+		}
+		deleted = append(deleted, set.ID)
+	}
+
+	return waitForZoneRecordSets(client, zoneID, pending, deleted, timeout)
+}
+
+func waitForZoneRecordSets(client *golangsdk.ServiceClient, zoneID string, pending, deleted []string, timeout time.Duration) error {
+	for _, id := range pending {
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE"},
+			Pending:    []string{"PENDING"},
+			Refresh:    waitForDNSRecordSet(client, zoneID, id),
+			Timeout:    timeout,
+			Delay:      time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for record set (%s) to become ACTIVE: %s", id, err)
+		}
+	}
+	for _, id := range deleted {
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"DELETED"},
+			Pending:    []string{"ACTIVE", "PENDING", "ERROR"},
+			Refresh:    waitForDNSRecordSet(client, zoneID, id),
+			Timeout:    timeout,
+			Delay:      time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for record set (%s) to become DELETED: %s", id, err)
+		}
+	}
+	return nil
+}
+
+func resourceDNSZoneRecordsV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	// record sets created before a failure are tracked in the state
+	d.SetId(d.Get("zone_id").(string))
+	if err := applyZoneRecordSets(d, dnsClient, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceDNSZoneRecordsV2Read(d, meta)
+}
+
+func resourceDNSZoneRecordsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	zone, err := zones.Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeleted(d, err, "error retrieving OpenTelekomCloud DNS zone")
+	}
+	existing, err := listZoneRecordSets(dnsClient, zone)
+	if err != nil {
+		return err
+	}
+
+	includeSOANS := d.Get("include_soa_ns").(bool)
+	var sets []zoneRecordSet
+	ids := make(map[string]string)
+	for _, set := range existing {
+		if !isManagedRecordSetType(set.Type, includeSOANS) {
+			continue
+		}
+		sets = append(sets, zoneRecordSet{
+			Name:    set.Name,
+			Type:    set.Type,
+			TTL:     set.TTL,
+			Records: append([]string{}, set.Records...),
+		})
+		ids[recordSetKey(set.Name, set.Type)] = fmt.Sprintf("%s/%s", d.Id(), set.ID)
+	}
+	sortZoneRecordSets(sets)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("zone_id", d.Id()),
+		d.Set("zone_name", zone.Name),
+		d.Set("recordset", flattenZoneRecordSets(sets)),
+		d.Set("recordset_ids", ids),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting DNS zone records fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDNSZoneRecordsV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	if d.HasChanges("zone_file", "recordset", "include_soa_ns") {
+		if err := applyZoneRecordSets(d, dnsClient, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceDNSZoneRecordsV2Read(d, meta)
+}
+
+func resourceDNSZoneRecordsV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	dnsClient, err := config.DnsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	zone, err := zones.Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeleted(d, err, "error retrieving OpenTelekomCloud DNS zone")
+	}
+	existing, err := listZoneRecordSets(dnsClient, zone)
+	if err != nil {
+		return err
+	}
+
+	// only record sets known to the resource are deleted
+	managed := make(map[string]bool)
+	for _, v := range d.Get("recordset_ids").(map[string]interface{}) {
+		_, id, err := ParseDNSV2RecordSetID(v.(string))
+		if err != nil {
+			return err
+		}
+		managed[id] = true
+	}
+
+	var deleted []string
+	for _, set := range existing {
+		if !managed[set.ID] || isZoneApexRecordSet(set, zone.Name) {
+			continue
+		}
+		if err := recordsets.Delete(dnsClient, d.Id(), set.ID).ExtractErr(); err != nil {
+			return fmt.Errorf("error deleting OpenTelekomCloud DNS record set: %s", err)
+		}
+		deleted = append(deleted, set.ID)
+	}
+
+	if err := waitForZoneRecordSets(dnsClient, d.Id(), nil, deleted, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceDNSZoneRecordsV2Import imports records of the zone as `recordset` blocks,
+// SOA and NS record sets are imported when ID is in format `zone_id/include_soa_ns`
+func resourceDNSZoneRecordsV2Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) > 2 || len(parts) == 2 && parts[1] != "include_soa_ns" {
+		return nil, fmt.Errorf("invalid format specified for DNS zone records, must be `zone_id` or `zone_id/include_soa_ns`")
+	}
+	d.SetId(parts[0])
+	if err := d.Set("include_soa_ns", len(parts) == 2); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
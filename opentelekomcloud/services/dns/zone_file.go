@@ -0,0 +1,322 @@
+package dns
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultZoneFileTTL = 300
+
+// zoneRecordSet is a set of records of the zone with the same name and type
+type zoneRecordSet struct {
+	Name    string
+	Type    string
+	TTL     int
+	Records []string
+}
+
+func (rs zoneRecordSet) key() string {
+	return recordSetKey(rs.Name, rs.Type)
+}
+
+func recordSetKey(name, recordType string) string {
+	return fmt.Sprintf("%s/%s", name, recordType)
+}
+
+var zoneFileClasses = map[string]bool{"IN": true, "CH": true, "HS": true, "CS": true}
+
+// parseZoneFile parses RFC 1035 zone file into record sets sorted by name and type,
+// relative names are completed with `origin` or `$ORIGIN` of the file
+func parseZoneFile(content, origin string) ([]zoneRecordSet, error) {
+	origin = fqdn(origin)
+	defaultTTL := -1
+	lastTTL := defaultZoneFileTTL
+	owner := ""
+
+	var sets []zoneRecordSet
+	index := make(map[string]int)
+
+	entries, err := zoneFileEntries(content)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		tokens := entry.tokens
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: invalid $ORIGIN directive", entry.line)
+			}
+			origin = qualifyName(tokens[1], origin)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: invalid $TTL directive", entry.line)
+			}
+			ttl, err := parseTTL(tokens[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", entry.line, err)
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s directive is not supported", entry.line, tokens[0])
+		}
+
+		if !entry.continued {
+			owner = tokens[0]
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record without owner name", entry.line)
+		}
+		if origin == "" && !strings.HasSuffix(owner, ".") {
+			return nil, fmt.Errorf("line %d: relative name %q without origin", entry.line, owner)
+		}
+
+		ttl := -1
+		for len(tokens) > 0 {
+			if zoneFileClasses[strings.ToUpper(tokens[0])] {
+				tokens = tokens[1:]
+				continue
+			}
+			if ttl < 0 {
+				if v, err := parseTTL(tokens[0]); err == nil {
+					ttl = v
+					tokens = tokens[1:]
+					continue
+				}
+			}
+			break
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record type and data expected", entry.line)
+		}
+		switch {
+		case ttl >= 0:
+			lastTTL = ttl
+		case defaultTTL >= 0:
+			ttl = defaultTTL
+		default:
+			ttl = lastTTL
+		}
+
+		recordType := strings.ToUpper(tokens[0])
+		data, err := qualifyRecordData(recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", entry.line, err)
+		}
+
+		name := qualifyName(owner, origin)
+		key := recordSetKey(name, recordType)
+		if i, ok := index[key]; ok {
+			sets[i].Records = append(sets[i].Records, data)
+			continue
+		}
+		index[key] = len(sets)
+		sets = append(sets, zoneRecordSet{
+			Name:    name,
+			Type:    recordType,
+			TTL:     ttl,
+			Records: []string{data},
+		})
+	}
+
+	sortZoneRecordSets(sets)
+	return sets, nil
+}
+
+func sortZoneRecordSets(sets []zoneRecordSet) {
+	for _, set := range sets {
+		sort.Strings(set.Records)
+	}
+	sort.Slice(sets, func(i, j int) bool {
+		if sets[i].Name != sets[j].Name {
+			return sets[i].Name < sets[j].Name
+		}
+		return sets[i].Type < sets[j].Type
+	})
+}
+
+type zoneFileEntry struct {
+	line      int
+	continued bool // owner is omitted, the previous one is used
+	tokens    []string
+}
+
+// zoneFileEntries splits zone file into entries, joining lines in parentheses and dropping comments
+func zoneFileEntries(content string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	var current *zoneFileEntry
+	depth := 0
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		tokens, opened, err := tokenizeZoneLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", lineNum, err)
+		}
+		if current == nil {
+			if len(tokens) == 0 {
+				continue
+			}
+			current = &zoneFileEntry{
+				line:      lineNum,
+				continued: line[0] == ' ' || line[0] == '\t',
+			}
+		}
+		current.tokens = append(current.tokens, tokens...)
+		depth += opened
+		if depth < 0 {
+			return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNum)
+		}
+		if depth == 0 {
+			if len(current.tokens) > 0 {
+				entries = append(entries, *current)
+			}
+			current = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses at the end of the zone file")
+	}
+	return entries, nil
+}
+
+// tokenizeZoneLine splits line into tokens, quoted strings are kept with quotes,
+// returns the change of parentheses depth
+func tokenizeZoneLine(line string) ([]string, int, error) {
+	var tokens []string
+	var token strings.Builder
+	depth := 0
+	quoted := false
+
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quoted:
+			token.WriteByte(c)
+			if c == '\\' && i+1 < len(line) {
+				i++
+				token.WriteByte(line[i])
+			} else if c == '"' {
+				quoted = false
+				flush()
+			}
+		case c == '"':
+			flush()
+			quoted = true
+			token.WriteByte(c)
+		case c == ';':
+			flush()
+			return tokens, depth, nil
+		case c == '(':
+			flush()
+			depth++
+		case c == ')':
+			flush()
+			depth--
+		case c == ' ' || c == '\t':
+			flush()
+		default:
+			token.WriteByte(c)
+		}
+	}
+	if quoted {
+		return nil, 0, fmt.Errorf("unterminated quoted string")
+	}
+	flush()
+	return tokens, depth, nil
+}
+
+// parseTTL parses TTL in seconds or with units, e.g. `1h30m`
+func parseTTL(value string) (int, error) {
+	if ttl, err := strconv.Atoi(value); err == nil && ttl >= 0 {
+		return ttl, nil
+	}
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, number := 0, ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		n, _ := strconv.Atoi(number)
+		total += n * unit
+		number = ""
+	}
+	if number != "" || value == "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	return total, nil
+}
+
+func fqdn(name string) string {
+	if name == "" || strings.HasSuffix(name, ".") {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(name) + "."
+}
+
+func qualifyName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+// qualifyRecordData completes relative domain names in the record data
+func qualifyRecordData(recordType string, fields []string, origin string) (string, error) {
+	// positions of domain names in the record data
+	var names []int
+	minFields := 1
+	switch recordType {
+	case "CNAME", "NS", "PTR":
+		names = []int{0}
+	case "MX":
+		names, minFields = []int{1}, 2
+	case "SRV":
+		names, minFields = []int{3}, 4
+	case "SOA":
+		names, minFields = []int{0, 1}, 7
+	}
+	if len(fields) < minFields {
+		return "", fmt.Errorf("not enough fields for %s record", recordType)
+	}
+
+	result := make([]string, len(fields))
+	copy(result, fields)
+	for _, i := range names {
+		if result[i] == "." {
+			continue
+		}
+		if origin == "" && !strings.HasSuffix(result[i], ".") {
+			return "", fmt.Errorf("relative name %q without origin", result[i])
+		}
+		result[i] = qualifyName(result[i], origin)
+	}
+	return strings.Join(result, " "), nil
+}
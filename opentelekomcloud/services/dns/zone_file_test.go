@@ -0,0 +1,233 @@
+package dns
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		origin   string
+		expected []zoneRecordSet
+	}{
+		{
+			name: "origin and ttl directives",
+			content: `
+$ORIGIN example.com.
+$TTL 1h
+@    IN A 192.0.2.1
+www     A 192.0.2.2
+`,
+			expected: []zoneRecordSet{
+				{Name: "example.com.", Type: "A", TTL: 3600, Records: []string{"192.0.2.1"}},
+				{Name: "www.example.com.", Type: "A", TTL: 3600, Records: []string{"192.0.2.2"}},
+			},
+		},
+		{
+			name:    "origin argument",
+			content: "www 600 IN A 192.0.2.1\n",
+			origin:  "example.com",
+			expected: []zoneRecordSet{
+				{Name: "www.example.com.", Type: "A", TTL: 600, Records: []string{"192.0.2.1"}},
+			},
+		},
+		{
+			name: "owner inheritance",
+			content: `
+www  300 IN A 192.0.2.2
+         IN A 192.0.2.1
+	     IN TXT "text"
+`,
+			origin: "example.com.",
+			expected: []zoneRecordSet{
+				{Name: "www.example.com.", Type: "A", TTL: 300, Records: []string{"192.0.2.1", "192.0.2.2"}},
+				{Name: "www.example.com.", Type: "TXT", TTL: 300, Records: []string{`"text"`}},
+			},
+		},
+		{
+			name: "multi-line parentheses",
+			content: `
+@ IN SOA ns1 hostmaster (
+      2021040801 ; serial
+      7200 3600 1209600
+      300 )
+`,
+			origin: "example.com.",
+			expected: []zoneRecordSet{
+				{
+					Name:    "example.com.",
+					Type:    "SOA",
+					TTL:     defaultZoneFileTTL,
+					Records: []string{"ns1.example.com. hostmaster.example.com. 2021040801 7200 3600 1209600 300"},
+				},
+			},
+		},
+		{
+			name:    "semicolon in quoted TXT",
+			content: `txt IN TXT "v=spf1; -all" ; comment` + "\n",
+			origin:  "example.com.",
+			expected: []zoneRecordSet{
+				{Name: "txt.example.com.", Type: "TXT", TTL: defaultZoneFileTTL, Records: []string{`"v=spf1; -all"`}},
+			},
+		},
+		{
+			name:    "ttl with units",
+			content: "www 1h30m IN A 192.0.2.1\n",
+			origin:  "example.com.",
+			expected: []zoneRecordSet{
+				{Name: "www.example.com.", Type: "A", TTL: 5400, Records: []string{"192.0.2.1"}},
+			},
+		},
+		{
+			name: "record data qualification",
+			content: `
+@     IN MX    10 mail
+alias IN CNAME www.example.org.
+_sip._tcp IN SRV 10 60 5060 sip
+`,
+			origin: "example.com.",
+			expected: []zoneRecordSet{
+				{Name: "_sip._tcp.example.com.", Type: "SRV", TTL: defaultZoneFileTTL, Records: []string{"10 60 5060 sip.example.com."}},
+				{Name: "alias.example.com.", Type: "CNAME", TTL: defaultZoneFileTTL, Records: []string{"www.example.org."}},
+				{Name: "example.com.", Type: "MX", TTL: defaultZoneFileTTL, Records: []string{"10 mail.example.com."}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sets, err := parseZoneFile(c.content, c.origin)
+			th.AssertNoErr(t, err)
+			th.AssertDeepEquals(t, c.expected, sets)
+		})
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		origin  string
+	}{
+		{
+			name:    "unbalanced parentheses",
+			content: "@ IN SOA ns1 hostmaster ( 1 2 3 4 5\n",
+			origin:  "example.com.",
+		},
+		{
+			name:    "closing parenthesis",
+			content: "www IN A 192.0.2.1 )\n",
+			origin:  "example.com.",
+		},
+		{
+			name:    "unterminated quote",
+			content: "txt IN TXT \"text\n",
+			origin:  "example.com.",
+		},
+		{
+			name:    "include directive",
+			content: "$INCLUDE other.zone\n",
+			origin:  "example.com.",
+		},
+		{
+			name:    "relative name without origin",
+			content: "www IN A 192.0.2.1\n",
+		},
+		{
+			name:    "relative record data without origin",
+			content: "www.example.com. IN CNAME web\n",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := parseZoneFile(c.content, c.origin); err == nil {
+				t.Errorf("expected error parsing %q", c.content)
+			}
+		})
+	}
+}
+
+func TestZoneFileEntries(t *testing.T) {
+	content := `
+; comment only
+www IN A 192.0.2.1
+    IN A 192.0.2.2
+@ IN SOA ns1 hostmaster (
+      1 2 3 4 5 )
+`
+	expected := []zoneFileEntry{
+		{line: 3, tokens: []string{"www", "IN", "A", "192.0.2.1"}},
+		{line: 4, continued: true, tokens: []string{"IN", "A", "192.0.2.2"}},
+		{line: 5, tokens: []string{"@", "IN", "SOA", "ns1", "hostmaster", "1", "2", "3", "4", "5"}},
+	}
+
+	entries, err := zoneFileEntries(content)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, entries)
+}
+
+func TestTokenizeZoneLine(t *testing.T) {
+	cases := []struct {
+		line   string
+		tokens []string
+		depth  int
+	}{
+		{line: "www IN A 192.0.2.1", tokens: []string{"www", "IN", "A", "192.0.2.1"}},
+		{line: "\twww\tIN A 192.0.2.1 ; comment", tokens: []string{"www", "IN", "A", "192.0.2.1"}},
+		{line: `txt TXT "a; b" "c \" d"`, tokens: []string{"txt", "TXT", `"a; b"`, `"c \" d"`}},
+		{line: "@ SOA ns1 hostmaster (", tokens: []string{"@", "SOA", "ns1", "hostmaster"}, depth: 1},
+		{line: "  1 2 3 4 5 )", tokens: []string{"1", "2", "3", "4", "5"}, depth: -1},
+		{line: "; comment only"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.line, func(t *testing.T) {
+			tokens, depth, err := tokenizeZoneLine(c.line)
+			th.AssertNoErr(t, err)
+			th.AssertDeepEquals(t, c.tokens, tokens)
+			th.AssertEquals(t, c.depth, depth)
+		})
+	}
+
+	if _, _, err := tokenizeZoneLine(`txt TXT "text`); err == nil {
+		t.Error("expected error for unterminated quoted string")
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected int
+		valid    bool
+	}{
+		{value: "300", expected: 300, valid: true},
+		{value: "0", expected: 0, valid: true},
+		{value: "1h30m", expected: 5400, valid: true},
+		{value: "1W2D", expected: 777600, valid: true},
+		{value: "90s", expected: 90, valid: true},
+		{value: ""},
+		{value: "-1"},
+		{value: "h"},
+		{value: "10x"},
+		{value: "1h30"},
+		{value: "IN"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			ttl, err := parseTTL(c.value)
+			if !c.valid {
+				if err == nil {
+					t.Errorf("expected error parsing TTL %q", c.value)
+				}
+				return
+			}
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, c.expected, ttl)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package kms
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+)
+
+const resourcePath = "kms"
+
+func kmsURL(client *golangsdk.ServiceClient, action string) string {
+	return client.ServiceURL(client.ProjectID, resourcePath, action)
+}
+
+func kmsPost(client *golangsdk.ServiceClient, action string, body, result interface{}) error {
+	_, err := client.Post(kmsURL(client, action), body, result, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// listAllKeys lists keys in the given state page by page, all keys are listed when state is empty
+func listAllKeys(client *golangsdk.ServiceClient, state string) ([]keys.Key, error) {
+	var allKeys []keys.Key
+	opts := keys.ListOpts{
+		KeyState: state,
+	}
+	for {
+		page, err := keys.List(client, opts).ExtractListKey()
+		if err != nil {
+			return nil, err
+		}
+		allKeys = append(allKeys, page.KeyDetails...)
+		if page.Truncated != "true" {
+			break
+		}
+		opts.Marker = page.NextMarker
+	}
+	return allKeys, nil
+}
+
+// createKeyOpts extends keys.CreateOpts with the origin of the key material
+type createKeyOpts struct {
+	KeyAlias       string `json:"key_alias" required:"true"`
+	KeyDescription string `json:"key_description,omitempty"`
+	Realm          string `json:"realm,omitempty"`
+	// Origin of the key material: `kms` or `external`
+	Origin string `json:"origin,omitempty"`
+}
+
+func (opts createKeyOpts) ToKeyCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+type keyRotationStatus struct {
+	Enabled           bool   `json:"key_rotation_enabled"`
+	Interval          int    `json:"rotation_interval"`
+	LastRotationTime  string `json:"last_rotation_time"`
+	NumberOfRotations int    `json:"number_of_rotations"`
+}
+
+func getKeyRotationStatus(client *golangsdk.ServiceClient, keyID string) (*keyRotationStatus, error) {
+	status := new(keyRotationStatus)
+	err := kmsPost(client, "get-key-rotation-status", map[string]interface{}{"key_id": keyID}, status)
+	return status, err
+}
+
+func enableKeyRotation(client *golangsdk.ServiceClient, keyID string) error {
+	return kmsPost(client, "enable-key-rotation", map[string]interface{}{"key_id": keyID}, nil)
+}
+
+func disableKeyRotation(client *golangsdk.ServiceClient, keyID string) error {
+	return kmsPost(client, "disable-key-rotation", map[string]interface{}{"key_id": keyID}, nil)
+}
+
+func updateKeyRotationInterval(client *golangsdk.ServiceClient, keyID string, interval int) error {
+	body := map[string]interface{}{
+		"key_id":            keyID,
+		"rotation_interval": interval,
+	}
+	return kmsPost(client, "update-key-rotation-interval", body, nil)
+}
+
+type createGrantOpts struct {
+	KeyID                string   `json:"key_id" required:"true"`
+	GranteePrincipal     string   `json:"grantee_principal" required:"true"`
+	GranteePrincipalType string   `json:"grantee_principal_type,omitempty"`
+	Operations           []string `json:"operations" required:"true"`
+	Name                 string   `json:"name,omitempty"`
+	RetiringPrincipal    string   `json:"retiring_principal,omitempty"`
+}
+
+type grant struct {
+	KeyID                string   `json:"key_id"`
+	GrantID              string   `json:"grant_id"`
+	GranteePrincipal     string   `json:"grantee_principal"`
+	GranteePrincipalType string   `json:"grantee_principal_type"`
+	Operations           []string `json:"operations"`
+	IssuingPrincipal     string   `json:"issuing_principal"`
+	CreationDate         string   `json:"creation_date"`
+	Name                 string   `json:"name"`
+	RetiringPrincipal    string   `json:"retiring_principal"`
+}
+
+func createGrant(client *golangsdk.ServiceClient, opts createGrantOpts) (string, error) {
+	body, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		GrantID string `json:"grant_id"`
+	}
+	if err := kmsPost(client, "create-grant", body, &result); err != nil {
+		return "", err
+	}
+	return result.GrantID, nil
+}
+
+// getGrant looks for the grant of the key page by page
+func getGrant(client *golangsdk.ServiceClient, keyID, grantID string) (*grant, error) {
+	body := map[string]interface{}{
+		"key_id": keyID,
+		"limit":  "100",
+	}
+	for {
+		var page struct {
+			Grants     []grant `json:"grants"`
+			NextMarker string  `json:"next_marker"`
+			Truncated  string  `json:"truncated"`
+		}
+		if err := kmsPost(client, "list-grants", body, &page); err != nil {
+			return nil, err
+		}
+		for _, g := range page.Grants {
+			if g.GrantID == grantID {
+				return &g, nil
+			}
+		}
+		if page.Truncated != "true" || page.NextMarker == "" {
+			break
+		}
+		body["marker"] = page.NextMarker
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func revokeGrant(client *golangsdk.ServiceClient, keyID, grantID string) error {
+	body := map[string]interface{}{
+		"key_id":   keyID,
+		"grant_id": grantID,
+	}
+	return kmsPost(client, "revoke-grant", body, nil)
+}
+
+type importParameters struct {
+	KeyID       string `json:"key_id"`
+	ImportToken string `json:"import_token"`
+	PublicKey   string `json:"public_key"`
+}
+
+func getParametersForImport(client *golangsdk.ServiceClient, keyID, wrappingAlgorithm string) (*importParameters, error) {
+	body := map[string]interface{}{
+		"key_id":             keyID,
+		"wrapping_algorithm": wrappingAlgorithm,
+	}
+	params := new(importParameters)
+	err := kmsPost(client, "get-parameters-for-import", body, params)
+	return params, err
+}
+
+type importKeyMaterialOpts struct {
+	KeyID                string `json:"key_id" required:"true"`
+	ImportToken          string `json:"import_token" required:"true"`
+	EncryptedKeyMaterial string `json:"encrypted_key_material" required:"true"`
+	// ExpirationTime is a Unix timestamp in seconds, the material never expires when omitted
+	ExpirationTime string `json:"expiration_time,omitempty"`
+}
+
+func importKeyMaterial(client *golangsdk.ServiceClient, opts importKeyMaterialOpts) error {
+	body, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	return kmsPost(client, "import-key-material", body, nil)
+}
+
+func deleteImportedKeyMaterial(client *golangsdk.ServiceClient, keyID string) error {
+	return kmsPost(client, "delete-imported-key-material", map[string]interface{}{"key_id": keyID}, nil)
+}
+
+// encryptData encrypts up to 4096 bytes of plaintext with the key, returns base64 encoded ciphertext
+func encryptData(client *golangsdk.ServiceClient, keyID, plainText string, context map[string]string) (string, error) {
+	body := map[string]interface{}{
+		"key_id":     keyID,
+		"plain_text": plainText,
+	}
+	if len(context) > 0 {
+		body["encryption_context"] = context
+	}
+	var result struct {
+		CipherText string `json:"cipher_text"`
+	}
+	if err := kmsPost(client, "encrypt-data", body, &result); err != nil {
+		return "", err
+	}
+	return result.CipherText, nil
+}
+
+// decryptData decrypts ciphertext returned by encryptData, the key is determined from the ciphertext
+func decryptData(client *golangsdk.ServiceClient, cipherText string, context map[string]string) (string, error) {
+	body := map[string]interface{}{
+		"cipher_text": cipherText,
+	}
+	if len(context) > 0 {
+		body["encryption_context"] = context
+	}
+	var result struct {
+		PlainText string `json:"plain_text"`
+	}
+	if err := kmsPost(client, "decrypt-data", body, &result); err != nil {
+		return "", err
+	}
+	return result.PlainText, nil
+}
+
+func expandEncryptionContext(raw map[string]interface{}) map[string]string {
+	context := make(map[string]string, len(raw))
+	for k, v := range raw {
+		context[k] = v.(string)
+	}
+	return context
+}
@@ -0,0 +1,65 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceKmsCiphertextV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKmsCiphertextV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"plaintext": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ciphertext": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsCiphertextV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	context := expandEncryptionContext(d.Get("encryption_context").(map[string]interface{}))
+
+	log.Printf("[DEBUG] KMS encrypt data with key: %s", keyID)
+	cipherText, err := encryptData(client, keyID, d.Get("plaintext").(string), context)
+	if err != nil {
+		return fmt.Errorf("error encrypting data with KMS key %s: %s", keyID, err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(cipherText)))
+
+	if err := d.Set("ciphertext", cipherText); err != nil {
+		return fmt.Errorf("error setting ciphertext: %s", err)
+	}
+	return nil
+}
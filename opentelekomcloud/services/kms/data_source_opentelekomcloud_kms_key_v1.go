@@ -85,24 +85,9 @@ func dataSourceKmsKeyV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud kms key client: %s", err)
 	}
 
-	is_list_key := true
-	next_marker := ""
-	allKeys := []keys.Key{}
-	for is_list_key {
-		req := &keys.ListOpts{
-			KeyState: d.Get("key_state").(string),
-			Limit:    "",
-			Marker:   next_marker,
-		}
-
-		v, err := keys.List(KmsKeyV1Client, req).ExtractListKey()
-		if err != nil {
-			return err
-		}
-
-		is_list_key = v.Truncated == "true"
-		next_marker = v.NextMarker
-		allKeys = append(allKeys, v.KeyDetails...)
+	allKeys, err := listAllKeys(KmsKeyV1Client, d.Get("key_state").(string))
+	if err != nil {
+		return err
 	}
 
 	keyProperties := map[string]string{}
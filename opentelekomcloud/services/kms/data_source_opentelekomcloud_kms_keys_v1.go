@@ -0,0 +1,155 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceKmsKeysV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKmsKeysV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"key_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					WaitingForEnableState, EnabledState, DisabledState, PendingDeletionState, PendingImportState,
+				}, false),
+			},
+			"alias_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"kms", "external",
+				}, false),
+			},
+			"default_key_flag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"0", "1",
+				}, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_key_flag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"realm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scheduled_deletion_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKmsKeysV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	allKeys, err := listAllKeys(client, d.Get("key_state").(string))
+	if err != nil {
+		return fmt.Errorf("error listing KMS keys: %s", err)
+	}
+
+	aliasPrefix := d.Get("alias_prefix").(string)
+	origin := d.Get("origin").(string)
+	defaultKeyFlag := d.Get("default_key_flag").(string)
+
+	var ids []string
+	var keyList []map[string]interface{}
+	for _, key := range allKeys {
+		if !strings.HasPrefix(key.KeyAlias, aliasPrefix) {
+			continue
+		}
+		if origin != "" && key.Origin != origin {
+			continue
+		}
+		if defaultKeyFlag != "" && key.DefaultKeyFlag != defaultKeyFlag {
+			continue
+		}
+		ids = append(ids, key.KeyID)
+		keyList = append(keyList, map[string]interface{}{
+			"id":                      key.KeyID,
+			"key_alias":               key.KeyAlias,
+			"key_description":         key.KeyDescription,
+			"key_state":               key.KeyState,
+			"origin":                  key.Origin,
+			"default_key_flag":        key.DefaultKeyFlag,
+			"realm":                   key.Realm,
+			"domain_id":               key.DomainID,
+			"creation_date":           key.CreationDate,
+			"scheduled_deletion_date": key.ScheduledDeletionDate,
+		})
+	}
+	log.Printf("[DEBUG] Found %d of %d KMS keys", len(ids), len(allKeys))
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+
+	mErr := multierror.Append(
+		d.Set("ids", ids),
+		d.Set("keys", keyList),
+	)
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,84 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceKmsSecretsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKmsSecretsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"secret": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ciphertext": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"encryption_context": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"plaintext": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceKmsSecretsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	secrets := d.Get("secret").(*schema.Set).List()
+	plaintext := make(map[string]string, len(secrets))
+	var ids []string
+	for _, raw := range secrets {
+		secret := raw.(map[string]interface{})
+		name := secret["name"].(string)
+		if _, ok := plaintext[name]; ok {
+			return fmt.Errorf("duplicate secret name: %s", name)
+		}
+
+		log.Printf("[DEBUG] KMS decrypt secret: %s", name)
+		context := expandEncryptionContext(secret["encryption_context"].(map[string]interface{}))
+		value, err := decryptData(client, secret["ciphertext"].(string), context)
+		if err != nil {
+			return fmt.Errorf("error decrypting secret %s: %s", name, err)
+		}
+		plaintext[name] = value
+		ids = append(ids, name+"="+secret["ciphertext"].(string))
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+
+	if err := d.Set("plaintext", plaintext); err != nil {
+		return fmt.Errorf("error setting plaintext: %s", err)
+	}
+	return nil
+}
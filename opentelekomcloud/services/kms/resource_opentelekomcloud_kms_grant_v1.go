@@ -0,0 +1,161 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceKmsGrantV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsGrantV1Create,
+		Read:   resourceKmsGrantV1Read,
+		Delete: resourceKmsGrantV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceKmsGrantV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "user",
+				ValidateFunc: validation.StringInSlice([]string{
+					"user", "domain",
+				}, false),
+			},
+			"operations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"create-datakey", "create-datakey-without-plaintext", "encrypt-datakey",
+						"decrypt-datakey", "describe-key", "create-grant", "retire-grant",
+						"encrypt-data", "decrypt-data",
+					}, false),
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retiring_principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"issuing_principal": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsGrantV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	opts := createGrantOpts{
+		KeyID:                d.Get("key_id").(string),
+		GranteePrincipal:     d.Get("grantee_principal").(string),
+		GranteePrincipalType: d.Get("grantee_principal_type").(string),
+		Operations:           common.ExpandToStringSlice(d.Get("operations").(*schema.Set).List()),
+		Name:                 d.Get("name").(string),
+		RetiringPrincipal:    d.Get("retiring_principal").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+
+	grantID, err := createGrant(client, opts)
+	if err != nil {
+		return fmt.Errorf("error creating KMS grant: %s", err)
+	}
+	d.SetId(grantID)
+
+	return resourceKmsGrantV1Read(d, meta)
+}
+
+func resourceKmsGrantV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	grant, err := getGrant(client, d.Get("key_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error reading KMS grant")
+	}
+	log.Printf("[DEBUG] KMS grant %s: %+v", d.Id(), grant)
+
+	mErr := multierror.Append(nil,
+		d.Set("key_id", grant.KeyID),
+		d.Set("grantee_principal", grant.GranteePrincipal),
+		d.Set("operations", grant.Operations),
+		d.Set("name", grant.Name),
+		d.Set("retiring_principal", grant.RetiringPrincipal),
+		d.Set("issuing_principal", grant.IssuingPrincipal),
+		d.Set("creation_date", grant.CreationDate),
+	)
+	if grant.GranteePrincipalType != "" {
+		mErr = multierror.Append(mErr, d.Set("grantee_principal_type", grant.GranteePrincipalType))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+func resourceKmsGrantV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	if err := revokeGrant(client, d.Get("key_id").(string), d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "error revoking KMS grant")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKmsGrantV1Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for KMS grant, must be <key_id>/<grant_id>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("key_id", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,202 @@
+package kms
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	wrappingOAEPSHA256 = "RSAES_OAEP_SHA_256"
+	wrappingOAEPSHA1   = "RSAES_OAEP_SHA_1"
+	wrappingPKCS1v15   = "RSAES_PKCS1_V1_5"
+)
+
+func ResourceKmsKeyMaterialV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsKeyMaterialV1Create,
+		Read:   resourceKmsKeyMaterialV1Read,
+		Delete: resourceKmsKeyMaterialV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_material": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validateKeyMaterial,
+			},
+			"wrapping_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  wrappingOAEPSHA256,
+				ValidateFunc: validation.StringInSlice([]string{
+					wrappingOAEPSHA256, wrappingOAEPSHA1, wrappingPKCS1v15,
+				}, false),
+			},
+			"expiration_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateKeyMaterial checks the material is a base64 encoded 256-bit symmetric key
+func validateKeyMaterial(v interface{}, k string) (ws []string, errors []error) {
+	material, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be base64 encoded: %s", k, err))
+		return
+	}
+	if len(material) != 32 {
+		errors = append(errors, fmt.Errorf("%q must be a 256-bit key, got %d bits", k, len(material)*8))
+	}
+	return
+}
+
+// wrapKeyMaterial encrypts the key material with the public key returned by KMS
+func wrapKeyMaterial(material []byte, publicKey, algorithm string) (string, error) {
+	der, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("error decoding wrapping key: %s", err)
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return "", fmt.Errorf("error parsing wrapping key: %s", err)
+	}
+	pub, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("wrapping key is not an RSA key")
+	}
+
+	var wrapped []byte
+	switch algorithm {
+	case wrappingOAEPSHA256:
+		wrapped, err = rsa.EncryptOAEP(sha256.New(), rand.Reader, pub, material, nil)
+	case wrappingOAEPSHA1:
+		wrapped, err = rsa.EncryptOAEP(sha1.New(), rand.Reader, pub, material, nil)
+	case wrappingPKCS1v15:
+		wrapped, err = rsa.EncryptPKCS1v15(rand.Reader, pub, material)
+	default:
+		return "", fmt.Errorf("unsupported wrapping algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error wrapping key material: %s", err)
+	}
+	return base64.StdEncoding.EncodeToString(wrapped), nil
+}
+
+func resourceKmsKeyMaterialV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	algorithm := d.Get("wrapping_algorithm").(string)
+	params, err := getParametersForImport(client, keyID, algorithm)
+	if err != nil {
+		return fmt.Errorf("error getting parameters for import of key material: %s", err)
+	}
+
+	material, _ := base64.StdEncoding.DecodeString(d.Get("key_material").(string))
+	wrapped, err := wrapKeyMaterial(material, params.PublicKey, algorithm)
+	if err != nil {
+		return err
+	}
+
+	opts := importKeyMaterialOpts{
+		KeyID:                keyID,
+		ImportToken:          params.ImportToken,
+		EncryptedKeyMaterial: wrapped,
+	}
+	if v, ok := d.GetOk("expiration_time"); ok {
+		expiration, _ := time.Parse(time.RFC3339, v.(string))
+		opts.ExpirationTime = strconv.FormatInt(expiration.Unix(), 10)
+	}
+
+	log.Printf("[DEBUG] Importing key material of KMS key %s", keyID)
+	if err := importKeyMaterial(client, opts); err != nil {
+		return fmt.Errorf("error importing key material: %s", err)
+	}
+	d.SetId(keyID)
+
+	return resourceKmsKeyMaterialV1Read(d, meta)
+}
+
+func resourceKmsKeyMaterialV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	key, err := keys.Get(client, d.Id()).ExtractKeyInfo()
+	if err != nil {
+		return common.CheckDeleted(d, err, "error reading KMS key")
+	}
+
+	if key.KeyState == PendingImportState || key.KeyState == PendingDeletionState {
+		log.Printf("[WARN] Removing key material of KMS key %s because it's already gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("key_id", key.KeyID),
+		d.Set("key_state", key.KeyState),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceKmsKeyMaterialV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	key, err := keys.Get(client, d.Id()).ExtractKeyInfo()
+	if err != nil {
+		return common.CheckDeleted(d, err, "error reading KMS key")
+	}
+
+	// material of the key scheduled for deletion is removed together with the key
+	if key.KeyState != PendingImportState && key.KeyState != PendingDeletionState {
+		if err := deleteImportedKeyMaterial(client, d.Id()); err != nil {
+			return fmt.Errorf("error deleting key material: %s", err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
@@ -21,6 +22,7 @@ const (
 	EnabledState          = "2"
 	DisabledState         = "3"
 	PendingDeletionState  = "4"
+	PendingImportState    = "5"
 )
 
 func ResourceKmsKeyV1() *schema.Resource {
@@ -34,6 +36,8 @@ func ResourceKmsKeyV1() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: validateKmsKeyRotation,
+
 		Schema: map[string]*schema.Schema{
 			"key_alias": {
 				Type:     schema.TypeString,
@@ -76,6 +80,26 @@ func ResourceKmsKeyV1() *schema.Resource {
 			},
 			"origin": {
 				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"kms", "external",
+				}, false),
+			},
+			"rotation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rotation_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(30, 365),
+			},
+			"rotation_number": {
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"pending_days": {
@@ -95,10 +119,11 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
 	}
 
-	createOpts := &keys.CreateOpts{
+	createOpts := createKeyOpts{
 		KeyAlias:       d.Get("key_alias").(string),
 		KeyDescription: d.Get("key_description").(string),
 		Realm:          d.Get("realm").(string),
+		Origin:         d.Get("origin").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -108,12 +133,12 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[INFO] Key ID: %s", key.KeyID)
 
-	// Wait for the key to become enabled.
+	// Wait for the key to become enabled, keys with external origin wait for the key material.
 	log.Printf("[DEBUG] Waiting for key (%s) to become enabled", key.KeyID)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{WaitingForEnableState, DisabledState},
-		Target:     []string{EnabledState},
+		Target:     []string{EnabledState, PendingImportState},
 		Refresh:    keyV1StateRefreshFunc(client, key.KeyID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
@@ -125,7 +150,18 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for key (%s) to become ready: %s", key.KeyID, err)
 	}
 
-	if !d.Get("is_enabled").(bool) {
+	if d.Get("rotation_enabled").(bool) {
+		if err := enableKeyRotation(client, key.KeyID); err != nil {
+			return fmt.Errorf("error enabling key rotation: %s", err)
+		}
+		if v, ok := d.GetOk("rotation_interval"); ok {
+			if err := updateKeyRotationInterval(client, key.KeyID, v.(int)); err != nil {
+				return fmt.Errorf("error updating key rotation interval: %s", err)
+			}
+		}
+	}
+
+	if !d.Get("is_enabled").(bool) && d.Get("origin").(string) != "external" {
 		disableKey, err := keys.DisableKey(client, key.KeyID).ExtractKeyInfo()
 		if err != nil {
 			return fmt.Errorf("error disabling key: %s", err)
@@ -187,6 +223,25 @@ func resourceKmsKeyV1Read(d *schema.ResourceData, meta interface{}) error {
 		return mErr
 	}
 
+	// rotation is available only for keys with material generated by KMS
+	if key.Origin != "external" {
+		rotation, err := getKeyRotationStatus(client, d.Id())
+		if err != nil {
+			return fmt.Errorf("error fetching key rotation status: %s", err)
+		}
+		mErr = multierror.Append(mErr,
+			d.Set("rotation_enabled", rotation.Enabled),
+			d.Set("rotation_number", rotation.NumberOfRotations),
+		)
+		// interval is set only together with enabled rotation
+		if rotation.Enabled {
+			mErr = multierror.Append(mErr, d.Set("rotation_interval", rotation.Interval))
+		}
+		if err := mErr.ErrorOrNil(); err != nil {
+			return err
+		}
+	}
+
 	// save tags
 	resourceTags, err := tags.Get(client, "kms", d.Id()).Extract()
 	if err != nil {
@@ -256,6 +311,26 @@ func resourceKmsKeyV1Update(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("rotation_enabled") {
+		if d.Get("rotation_enabled").(bool) {
+			err = enableKeyRotation(client, d.Id())
+		} else {
+			err = disableKeyRotation(client, d.Id())
+		}
+		if err != nil {
+			return fmt.Errorf("error updating key rotation: %s", err)
+		}
+	}
+
+	// interval kept in the state while rotation was disabled has to be applied on enabling
+	if d.HasChanges("rotation_enabled", "rotation_interval") && d.Get("rotation_enabled").(bool) {
+		if v, ok := d.GetOk("rotation_interval"); ok {
+			if err := updateKeyRotationInterval(client, d.Id(), v.(int)); err != nil {
+				return fmt.Errorf("error updating key rotation interval: %s", err)
+			}
+		}
+	}
+
 	// update tags
 	if d.HasChange("tags") {
 		if err := common.UpdateResourceTags(client, d, "kms", d.Id()); err != nil {
@@ -317,3 +392,11 @@ func keyV1StateRefreshFunc(client *golangsdk.ServiceClient, keyID string) resour
 		return v, v.KeyState, nil
 	}
 }
+
+// validateKmsKeyRotation rejects rotation of keys with imported material
+func validateKmsKeyRotation(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("rotation_enabled").(bool) && d.Get("origin").(string) == "external" {
+		return fmt.Errorf("rotation can't be enabled for the key with `external` origin")
+	}
+	return nil
+}
@@ -0,0 +1,373 @@
+package lts
+
+import (
+	"net/url"
+	"strings"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ltsURL builds URL of LTS v2 API, the client is created for legacy v2.0 API
+func ltsURL(client *golangsdk.ServiceClient, parts ...string) string {
+	base := strings.Replace(client.ResourceBaseURL(), "/v2.0/", "/v2/", 1)
+	return base + strings.Join(parts, "/")
+}
+
+// ltsV3URL builds URL of LTS v3 API used for agent host groups and access configurations
+func ltsV3URL(client *golangsdk.ServiceClient, parts ...string) string {
+	base := strings.Replace(client.ResourceBaseURL(), "/v2.0/", "/v3/", 1)
+	return base + strings.Join(parts, "/")
+}
+
+type logGroup struct {
+	ID           string `json:"log_group_id"`
+	Name         string `json:"log_group_name"`
+	CreationTime int64  `json:"creation_time"`
+	TTLInDays    int    `json:"ttl_in_days"`
+}
+
+type logStream struct {
+	ID           string `json:"log_stream_id"`
+	Name         string `json:"log_stream_name"`
+	CreationTime int64  `json:"creation_time"`
+	FilterCount  int    `json:"filter_count"`
+}
+
+func updateLogGroupTTL(client *golangsdk.ServiceClient, id string, ttl int) error {
+	body := map[string]interface{}{
+		"ttl_in_days": ttl,
+	}
+	_, err := client.Post(ltsURL(client, "groups", id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func listLogGroups(client *golangsdk.ServiceClient) ([]logGroup, error) {
+	var result struct {
+		LogGroups []logGroup `json:"log_groups"`
+	}
+	_, err := client.Get(ltsURL(client, "groups"), &result, nil)
+	return result.LogGroups, err
+}
+
+func listLogStreams(client *golangsdk.ServiceClient, groupID string) ([]logStream, error) {
+	var result struct {
+		LogStreams []logStream `json:"log_streams"`
+	}
+	_, err := client.Get(ltsURL(client, "groups", groupID, "streams"), &result, nil)
+	return result.LogStreams, err
+}
+
+type structField struct {
+	FieldName  string `json:"field_name"`
+	Type       string `json:"type"`
+	Content    string `json:"content,omitempty"`
+	IsAnalysis bool   `json:"is_analysis"`
+}
+
+type structConfigOpts struct {
+	LogGroupID  string        `json:"log_group_id"`
+	LogStreamID string        `json:"log_stream_id"`
+	ProjectID   string        `json:"project_id"`
+	ParseType   string        `json:"parse_type"`
+	Content     string        `json:"content"`
+	RegexRules  string        `json:"regex_rules,omitempty"`
+	SplitChar   string        `json:"split_char,omitempty"`
+	Layers      int           `json:"layers,omitempty"`
+	DemoFields  []structField `json:"demo_fields"`
+}
+
+type structConfig struct {
+	ID          string        `json:"id"`
+	LogGroupID  string        `json:"log_group_id"`
+	LogStreamID string        `json:"log_stream_id"`
+	ParseType   string        `json:"parse_type"`
+	Content     string        `json:"content"`
+	RegexRules  string        `json:"regex_rules"`
+	SplitChar   string        `json:"split_char"`
+	Layers      int           `json:"layers"`
+	DemoFields  []structField `json:"demo_fields"`
+}
+
+func structTemplateURL(client *golangsdk.ServiceClient) string {
+	return ltsURL(client, "lts", "struct", "template")
+}
+
+func createStructConfig(client *golangsdk.ServiceClient, opts structConfigOpts) error {
+	_, err := client.Post(structTemplateURL(client), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return err
+}
+
+func getStructConfig(client *golangsdk.ServiceClient, groupID, streamID string) (*structConfig, error) {
+	query := url.Values{}
+	query.Set("log_group_id", groupID)
+	query.Set("log_stream_id", streamID)
+	config := new(structConfig)
+	_, err := client.Get(structTemplateURL(client)+"?"+query.Encode(), config, nil)
+	if err != nil {
+		return nil, err
+	}
+	if config.ID == "" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return config, nil
+}
+
+func updateStructConfig(client *golangsdk.ServiceClient, opts structConfigOpts) error {
+	_, err := client.Put(structTemplateURL(client), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return err
+}
+
+func deleteStructConfig(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Request("DELETE", structTemplateURL(client), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{"id": id},
+		OkCodes:  []int{200, 204},
+	})
+	return err
+}
+
+type transferDetail struct {
+	ObsPeriod         int    `json:"obs_period"`
+	ObsPeriodUnit     string `json:"obs_period_unit"`
+	ObsBucketName     string `json:"obs_bucket_name"`
+	ObsDirPrefixName  string `json:"obs_dir_pre_fix_name,omitempty"`
+	ObsPrefixName     string `json:"obs_prefix_name,omitempty"`
+	ObsTimeZone       string `json:"obs_time_zone,omitempty"`
+	ObsTimeZoneID     string `json:"obs_time_zone_id,omitempty"`
+	ObsEncryptEnabled bool   `json:"obs_encrypted_enable"`
+	ObsEncryptID      string `json:"obs_encrypted_id,omitempty"`
+}
+
+type transferInfo struct {
+	Type          string         `json:"log_transfer_type"`
+	Mode          string         `json:"log_transfer_mode"`
+	StorageFormat string         `json:"log_storage_format"`
+	Status        string         `json:"log_transfer_status"`
+	Detail        transferDetail `json:"log_transfer_detail"`
+}
+
+type transferStream struct {
+	ID   string `json:"log_stream_id"`
+	Name string `json:"log_stream_name,omitempty"`
+}
+
+type transfer struct {
+	ID         string           `json:"log_transfer_id"`
+	LogGroupID string           `json:"log_group_id"`
+	LogStreams []transferStream `json:"log_streams"`
+	Info       transferInfo     `json:"log_transfer_info"`
+}
+
+func createTransfer(client *golangsdk.ServiceClient, groupID string, streams []transferStream, info transferInfo) (string, error) {
+	body := map[string]interface{}{
+		"log_group_id":      groupID,
+		"log_streams":       streams,
+		"log_transfer_info": info,
+	}
+	var result struct {
+		ID string `json:"log_transfer_id"`
+	}
+	_, err := client.Post(ltsURL(client, "transfers"), body, &result, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return result.ID, err
+}
+
+// getTransfer looks for the transfer in the list of OBS transfers, there is no API to get a single transfer
+func getTransfer(client *golangsdk.ServiceClient, id string) (*transfer, error) {
+	var result struct {
+		LogTransfers []transfer `json:"log_transfers"`
+	}
+	_, err := client.Get(ltsURL(client, "transfers")+"?log_transfer_type=OBS", &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range result.LogTransfers {
+		if t.ID == id {
+			return &t, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func updateTransfer(client *golangsdk.ServiceClient, id string, info transferInfo) error {
+	body := map[string]interface{}{
+		"log_transfer_id": id,
+		"log_transfer_info": map[string]interface{}{
+			"log_storage_format":  info.StorageFormat,
+			"log_transfer_status": info.Status,
+			"log_transfer_detail": info.Detail,
+		},
+	}
+	_, err := client.Put(ltsURL(client, "transfers"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteTransfer(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(ltsURL(client, "transfers")+"?log_transfer_id="+url.QueryEscape(id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+type hostGroupOpts struct {
+	ID              string   `json:"host_group_id,omitempty"`
+	Name            string   `json:"host_group_name"`
+	Type            string   `json:"host_group_type,omitempty"`
+	HostIDs         []string `json:"host_id_list"`
+	AgentAccessType string   `json:"agent_access_type,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
+}
+
+type hostGroup struct {
+	ID              string   `json:"host_group_id"`
+	Name            string   `json:"host_group_name"`
+	Type            string   `json:"host_group_type"`
+	HostIDs         []string `json:"host_id_list"`
+	AgentAccessType string   `json:"agent_access_type"`
+	Labels          []string `json:"labels"`
+	CreateTime      int64    `json:"create_time"`
+	UpdateTime      int64    `json:"update_time"`
+}
+
+func createHostGroup(client *golangsdk.ServiceClient, opts hostGroupOpts) (*hostGroup, error) {
+	group := new(hostGroup)
+	_, err := client.Post(ltsV3URL(client, "lts", "host-group"), opts, group, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return group, err
+}
+
+func getHostGroup(client *golangsdk.ServiceClient, id string) (*hostGroup, error) {
+	body := map[string]interface{}{
+		"host_group_id_list": []string{id},
+	}
+	var result struct {
+		Result []hostGroup `json:"result"`
+	}
+	_, err := client.Post(ltsV3URL(client, "lts", "host-group-list"), body, &result, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range result.Result {
+		if group.ID == id {
+			return &group, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func updateHostGroup(client *golangsdk.ServiceClient, opts hostGroupOpts) error {
+	_, err := client.Put(ltsV3URL(client, "lts", "host-group"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteHostGroup(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Request("DELETE", ltsV3URL(client, "lts", "host-group"), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{"host_group_id_list": []string{id}},
+		OkCodes:  []int{200, 204},
+	})
+	return err
+}
+
+type accessConfigFormatRule struct {
+	Mode  string `json:"mode"`
+	Value string `json:"value,omitempty"`
+}
+
+type accessConfigFormat struct {
+	Single *accessConfigFormatRule `json:"single,omitempty"`
+	Multi  *accessConfigFormatRule `json:"multi,omitempty"`
+}
+
+type accessConfigDetail struct {
+	PathType   string             `json:"pathType,omitempty"`
+	Paths      []string           `json:"paths,omitempty"`
+	BlackPaths []string           `json:"black_paths,omitempty"`
+	Format     accessConfigFormat `json:"format"`
+	Stdout     bool               `json:"stdout"`
+	Stderr     bool               `json:"stderr"`
+}
+
+type accessConfigLogInfo struct {
+	LogGroupID  string `json:"log_group_id"`
+	LogStreamID string `json:"log_stream_id"`
+}
+
+type accessConfigHostGroupInfo struct {
+	HostGroupIDs []string `json:"host_group_id_list"`
+}
+
+type accessConfigOpts struct {
+	ID            string                    `json:"access_config_id,omitempty"`
+	Name          string                    `json:"access_config_name,omitempty"`
+	Type          string                    `json:"access_config_type,omitempty"`
+	Detail        accessConfigDetail        `json:"access_config_detail"`
+	LogInfo       accessConfigLogInfo       `json:"log_info"`
+	HostGroupInfo accessConfigHostGroupInfo `json:"host_group_info"`
+	ClusterID     string                    `json:"cluster_id,omitempty"`
+}
+
+type accessConfig struct {
+	ID            string                    `json:"access_config_id"`
+	Name          string                    `json:"access_config_name"`
+	Type          string                    `json:"access_config_type"`
+	Detail        accessConfigDetail        `json:"access_config_detail"`
+	LogInfo       accessConfigLogInfo       `json:"log_info"`
+	HostGroupInfo accessConfigHostGroupInfo `json:"host_group_info"`
+	ClusterID     string                    `json:"cluster_id"`
+	CreateTime    int64                     `json:"create_time"`
+}
+
+func createAccessConfig(client *golangsdk.ServiceClient, opts accessConfigOpts) (*accessConfig, error) {
+	config := new(accessConfig)
+	_, err := client.Post(ltsV3URL(client, "lts", "access-config"), opts, config, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return config, err
+}
+
+// getAccessConfig looks for the configuration in the list of access configurations, there is no API to get a single one
+func getAccessConfig(client *golangsdk.ServiceClient, id string) (*accessConfig, error) {
+	var result struct {
+		Result []accessConfig `json:"result"`
+	}
+	_, err := client.Post(ltsV3URL(client, "lts", "access-config-list"), map[string]interface{}{}, &result, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range result.Result {
+		if config.ID == id {
+			return &config, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func updateAccessConfig(client *golangsdk.ServiceClient, opts accessConfigOpts) error {
+	_, err := client.Put(ltsV3URL(client, "lts", "access-config"), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteAccessConfig(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Request("DELETE", ltsV3URL(client, "lts", "access-config"), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{"access_config_list": []string{id}},
+		OkCodes:  []int{200, 204},
+	})
+	return err
+}
@@ -0,0 +1,66 @@
+package lts
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceLTSGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGroupV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ttl_in_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	groups, err := listLogGroups(client)
+	if err != nil {
+		return fmt.Errorf("Error listing log groups: %s", err)
+	}
+
+	name := d.Get("group_name").(string)
+	var group *logGroup
+	for i := range groups {
+		if groups[i].Name == name {
+			group = &groups[i]
+			break
+		}
+	}
+	if group == nil {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	log.Printf("[DEBUG] Retrieved log group %s: %#v", group.ID, group)
+
+	d.SetId(group.ID)
+	mErr := multierror.Append(nil,
+		d.Set("ttl_in_days", group.TTLInDays),
+		d.Set("creation_time", group.CreationTime),
+	)
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,71 @@
+package lts
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceLTSTopicV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTopicV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"topic_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTopicV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	groupID := d.Get("group_id").(string)
+	streams, err := listLogStreams(client, groupID)
+	if err != nil {
+		return fmt.Errorf("Error listing log topics of group %s: %s", groupID, err)
+	}
+
+	name := d.Get("topic_name").(string)
+	var stream *logStream
+	for i := range streams {
+		if streams[i].Name == name {
+			stream = &streams[i]
+			break
+		}
+	}
+	if stream == nil {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	log.Printf("[DEBUG] Retrieved log topic %s: %#v", stream.ID, stream)
+
+	d.SetId(stream.ID)
+	mErr := multierror.Append(nil,
+		d.Set("filter_count", stream.FilterCount),
+		d.Set("creation_time", stream.CreationTime),
+	)
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,268 @@
+package lts
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceLTSAccessConfigV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccessConfigCreate,
+		Read:   resourceAccessConfigRead,
+		Update: resourceAccessConfigUpdate,
+		Delete: resourceAccessConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "AGENT",
+				ValidateFunc: validation.StringInSlice([]string{
+					"AGENT", "K8S_CCE",
+				}, false),
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"topic_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"host_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"path_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"container_stdout", "container_file", "host_file",
+				}, false),
+			},
+			"paths": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"black_paths": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"stdout": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"stderr": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"multiline": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"time", "regular",
+							}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildAccessConfigDetail(d *schema.ResourceData) (accessConfigDetail, error) {
+	detail := accessConfigDetail{
+		PathType:   d.Get("path_type").(string),
+		Paths:      common.ExpandToStringSlice(d.Get("paths").(*schema.Set).List()),
+		BlackPaths: common.ExpandToStringSlice(d.Get("black_paths").(*schema.Set).List()),
+		Stdout:     d.Get("stdout").(bool),
+		Stderr:     d.Get("stderr").(bool),
+	}
+
+	switch d.Get("type").(string) {
+	case "AGENT":
+		if len(detail.Paths) == 0 {
+			return detail, fmt.Errorf("paths must be set for AGENT access configuration")
+		}
+	case "K8S_CCE":
+		if d.Get("cluster_id").(string) == "" || detail.PathType == "" {
+			return detail, fmt.Errorf("cluster_id and path_type must be set for K8S_CCE access configuration")
+		}
+		if detail.PathType == "container_stdout" && !detail.Stdout && !detail.Stderr {
+			return detail, fmt.Errorf("at least one of stdout or stderr must be enabled for container_stdout path_type")
+		}
+	}
+
+	// each line is a separate log event by default
+	detail.Format.Single = &accessConfigFormatRule{Mode: "system"}
+	if rawMultiline := d.Get("multiline").([]interface{}); len(rawMultiline) > 0 {
+		multiline := rawMultiline[0].(map[string]interface{})
+		detail.Format.Single = nil
+		detail.Format.Multi = &accessConfigFormatRule{
+			Mode:  multiline["mode"].(string),
+			Value: multiline["value"].(string),
+		}
+	}
+	return detail, nil
+}
+
+func buildAccessConfigOpts(d *schema.ResourceData) (accessConfigOpts, error) {
+	detail, err := buildAccessConfigDetail(d)
+	if err != nil {
+		return accessConfigOpts{}, err
+	}
+	return accessConfigOpts{
+		Detail: detail,
+		LogInfo: accessConfigLogInfo{
+			LogGroupID:  d.Get("group_id").(string),
+			LogStreamID: d.Get("topic_id").(string),
+		},
+		HostGroupInfo: accessConfigHostGroupInfo{
+			HostGroupIDs: common.ExpandToStringSlice(d.Get("host_group_ids").(*schema.Set).List()),
+		},
+	}, nil
+}
+
+func resourceAccessConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	createOpts, err := buildAccessConfigOpts(d)
+	if err != nil {
+		return err
+	}
+	createOpts.Name = d.Get("name").(string)
+	createOpts.Type = d.Get("type").(string)
+	createOpts.ClusterID = d.Get("cluster_id").(string)
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	accessConfig, err := createAccessConfig(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating access configuration: %s", err)
+	}
+	d.SetId(accessConfig.ID)
+
+	return resourceAccessConfigRead(d, meta)
+}
+
+func resourceAccessConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	accessConfig, err := getAccessConfig(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "Error getting access configuration")
+	}
+	log.Printf("[DEBUG] Retrieved access configuration %s: %#v", d.Id(), accessConfig)
+
+	detail := accessConfig.Detail
+	var multiline []map[string]interface{}
+	if detail.Format.Multi != nil {
+		multiline = []map[string]interface{}{
+			{
+				"mode":  detail.Format.Multi.Mode,
+				"value": detail.Format.Multi.Value,
+			},
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", accessConfig.Name),
+		d.Set("type", accessConfig.Type),
+		d.Set("group_id", accessConfig.LogInfo.LogGroupID),
+		d.Set("topic_id", accessConfig.LogInfo.LogStreamID),
+		d.Set("host_group_ids", accessConfig.HostGroupInfo.HostGroupIDs),
+		d.Set("cluster_id", accessConfig.ClusterID),
+		d.Set("path_type", detail.PathType),
+		d.Set("paths", detail.Paths),
+		d.Set("black_paths", detail.BlackPaths),
+		d.Set("stdout", detail.Stdout),
+		d.Set("stderr", detail.Stderr),
+		d.Set("multiline", multiline),
+		d.Set("create_time", accessConfig.CreateTime),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceAccessConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	updateOpts, err := buildAccessConfigOpts(d)
+	if err != nil {
+		return err
+	}
+	updateOpts.ID = d.Id()
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+	if err := updateAccessConfig(client, updateOpts); err != nil {
+		return fmt.Errorf("Error updating access configuration %s: %s", d.Id(), err)
+	}
+
+	return resourceAccessConfigRead(d, meta)
+}
+
+func resourceAccessConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	if err := deleteAccessConfig(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "Error deleting access configuration")
+	}
+
+	d.SetId("")
+	return nil
+}
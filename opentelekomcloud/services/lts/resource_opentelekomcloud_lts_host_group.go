@@ -0,0 +1,167 @@
+package lts
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceLTSHostGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceHostGroupCreate,
+		Read:   resourceHostGroupRead,
+		Update: resourceHostGroupUpdate,
+		Delete: resourceHostGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "linux",
+				ValidateFunc: validation.StringInSlice([]string{
+					"linux", "windows",
+				}, false),
+			},
+			"host_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"labels"},
+			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"host_ids"},
+			},
+			"agent_access_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// expandHostGroupMembers sets either ECS IDs or, for hosts of CCE node pools, labels
+// of the host group together with the matching agent access type
+func expandHostGroupMembers(d *schema.ResourceData, opts *hostGroupOpts) {
+	if labels := d.Get("labels").(*schema.Set); labels.Len() > 0 {
+		opts.AgentAccessType = "LABEL"
+		opts.Labels = common.ExpandToStringSlice(labels.List())
+		opts.HostIDs = []string{}
+		return
+	}
+	opts.AgentAccessType = "IP"
+	opts.HostIDs = common.ExpandToStringSlice(d.Get("host_ids").(*schema.Set).List())
+}
+
+func resourceHostGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	createOpts := hostGroupOpts{
+		Name: d.Get("name").(string),
+		Type: d.Get("type").(string),
+	}
+	expandHostGroupMembers(d, &createOpts)
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	group, err := createHostGroup(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating host group: %s", err)
+	}
+	d.SetId(group.ID)
+
+	return resourceHostGroupRead(d, meta)
+}
+
+func resourceHostGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	group, err := getHostGroup(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "Error getting host group")
+	}
+	log.Printf("[DEBUG] Retrieved host group %s: %#v", d.Id(), group)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", group.Name),
+		d.Set("type", group.Type),
+		d.Set("labels", group.Labels),
+		d.Set("agent_access_type", group.AgentAccessType),
+		d.Set("create_time", group.CreateTime),
+		d.Set("update_time", group.UpdateTime),
+	)
+	// hosts matched by labels are managed by CCE, not by the configuration
+	if group.AgentAccessType != "LABEL" {
+		mErr = multierror.Append(mErr, d.Set("host_ids", group.HostIDs))
+	}
+	return mErr.ErrorOrNil()
+}
+
+func resourceHostGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	updateOpts := hostGroupOpts{
+		ID:   d.Id(),
+		Name: d.Get("name").(string),
+	}
+	expandHostGroupMembers(d, &updateOpts)
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+	if err := updateHostGroup(client, updateOpts); err != nil {
+		return fmt.Errorf("Error updating host group %s: %s", d.Id(), err)
+	}
+
+	return resourceHostGroupRead(d, meta)
+}
+
+func resourceHostGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	if err := deleteHostGroup(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "Error deleting host group")
+	}
+
+	d.SetId("")
+	return nil
+}
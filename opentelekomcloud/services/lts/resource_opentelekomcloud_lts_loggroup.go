@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/loggroups"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -15,6 +16,7 @@ func ResourceLTSGroupV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGroupV2Create,
 		Read:   resourceGroupV2Read,
+		Update: resourceGroupV2Update,
 		Delete: resourceGroupV2Delete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -28,8 +30,10 @@ func ResourceLTSGroupV2() *schema.Resource {
 			},
 
 			"ttl_in_days": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 30),
 			},
 		},
 	}
@@ -54,6 +58,14 @@ func resourceGroupV2Create(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(groupCreate.ID)
+
+	// TTL can't be set on creation
+	if ttl, ok := d.GetOk("ttl_in_days"); ok {
+		if err := updateLogGroupTTL(client, d.Id(), ttl.(int)); err != nil {
+			return fmt.Errorf("Error setting TTL of log group: %s", err)
+		}
+	}
+
 	return resourceGroupV2Read(d, meta)
 }
 
@@ -76,6 +88,22 @@ func resourceGroupV2Read(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceGroupV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	if d.HasChange("ttl_in_days") {
+		if err := updateLogGroupTTL(client, d.Id(), d.Get("ttl_in_days").(int)); err != nil {
+			return fmt.Errorf("Error updating TTL of log group %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceGroupV2Read(d, meta)
+}
+
 func resourceGroupV2Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.LtsV2Client(config.GetRegion(d))
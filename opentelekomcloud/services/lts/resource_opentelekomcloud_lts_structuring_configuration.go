@@ -0,0 +1,235 @@
+package lts
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceLTSStructuringConfigurationV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStructuringConfigurationV2Create,
+		Read:   resourceStructuringConfigurationV2Read,
+		Update: resourceStructuringConfigurationV2Update,
+		Delete: resourceStructuringConfigurationV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStructuringConfigurationV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"structure_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"json", "split", "custom_regex", "nginx",
+				}, false),
+			},
+			"sample_log": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"regex_rules": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"split_char": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json_layers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 4),
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"string", "long", "float",
+							}, false),
+						},
+						"is_analysis": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildStructConfigOpts(d *schema.ResourceData, projectID string) (structConfigOpts, error) {
+	opts := structConfigOpts{
+		LogGroupID:  d.Get("group_id").(string),
+		LogStreamID: d.Get("topic_id").(string),
+		ProjectID:   projectID,
+		ParseType:   d.Get("structure_type").(string),
+		Content:     d.Get("sample_log").(string),
+		RegexRules:  d.Get("regex_rules").(string),
+		SplitChar:   d.Get("split_char").(string),
+		Layers:      d.Get("json_layers").(int),
+	}
+	switch {
+	case opts.ParseType == "custom_regex" && opts.RegexRules == "":
+		return opts, fmt.Errorf("regex_rules must be set for custom_regex structure_type")
+	case opts.ParseType == "split" && opts.SplitChar == "":
+		return opts, fmt.Errorf("split_char must be set for split structure_type")
+	}
+
+	rawFields := d.Get("fields").([]interface{})
+	opts.DemoFields = make([]structField, len(rawFields))
+	for i, v := range rawFields {
+		field := v.(map[string]interface{})
+		opts.DemoFields[i] = structField{
+			FieldName:  field["field_name"].(string),
+			Type:       field["type"].(string),
+			IsAnalysis: field["is_analysis"].(bool),
+		}
+	}
+	return opts, nil
+}
+
+func resourceStructuringConfigurationV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	createOpts, err := buildStructConfigOpts(d, client.ProjectID)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	if err := createStructConfig(client, createOpts); err != nil {
+		return fmt.Errorf("Error creating log structuring configuration: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", createOpts.LogGroupID, createOpts.LogStreamID))
+
+	return resourceStructuringConfigurationV2Read(d, meta)
+}
+
+func resourceStructuringConfigurationV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	structure, err := getStructConfig(client, d.Get("group_id").(string), d.Get("topic_id").(string))
+	if err != nil {
+		return common.CheckDeleted(d, err, "Error getting log structuring configuration")
+	}
+	log.Printf("[DEBUG] Retrieved log structuring configuration %s: %#v", d.Id(), structure)
+
+	fields := make([]map[string]interface{}, len(structure.DemoFields))
+	for i, field := range structure.DemoFields {
+		fields[i] = map[string]interface{}{
+			"field_name":  field.FieldName,
+			"type":        field.Type,
+			"is_analysis": field.IsAnalysis,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("fields", fields),
+	)
+	if structure.ParseType != "" {
+		mErr = multierror.Append(mErr, d.Set("structure_type", structure.ParseType))
+	}
+	if structure.Content != "" {
+		mErr = multierror.Append(mErr, d.Set("sample_log", structure.Content))
+	}
+	if structure.RegexRules != "" {
+		mErr = multierror.Append(mErr, d.Set("regex_rules", structure.RegexRules))
+	}
+	if structure.SplitChar != "" {
+		mErr = multierror.Append(mErr, d.Set("split_char", structure.SplitChar))
+	}
+	if structure.Layers != 0 {
+		mErr = multierror.Append(mErr, d.Set("json_layers", structure.Layers))
+	}
+	return mErr.ErrorOrNil()
+}
+
+func resourceStructuringConfigurationV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	updateOpts, err := buildStructConfigOpts(d, client.ProjectID)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+	if err := updateStructConfig(client, updateOpts); err != nil {
+		return fmt.Errorf("Error updating log structuring configuration %s: %s", d.Id(), err)
+	}
+
+	return resourceStructuringConfigurationV2Read(d, meta)
+}
+
+func resourceStructuringConfigurationV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	structure, err := getStructConfig(client, d.Get("group_id").(string), d.Get("topic_id").(string))
+	if err != nil {
+		return common.CheckDeleted(d, err, "Error getting log structuring configuration")
+	}
+
+	if err := deleteStructConfig(client, structure.ID); err != nil {
+		return common.CheckDeleted(d, err, "Error deleting log structuring configuration")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceStructuringConfigurationV2Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid format specified for log structuring configuration. Format must be <group id>/<topic id>")
+	}
+
+	d.Set("group_id", parts[0])
+	d.Set("topic_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,209 @@
+package lts
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceLTSTransferV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransferV2Create,
+		Read:   resourceTransferV2Read,
+		Update: resourceTransferV2Update,
+		Delete: resourceTransferV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"obs_bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 5, 6, 12, 30}),
+			},
+			"period_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"min", "hour",
+				}, false),
+			},
+			"storage_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "RAW",
+				ValidateFunc: validation.StringInSlice([]string{
+					"RAW", "JSON",
+				}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"dir_prefix_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"prefix_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"time_zone_id"},
+			},
+			"time_zone_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"time_zone"},
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func transferStatus(enabled bool) string {
+	if enabled {
+		return "ENABLE"
+	}
+	return "DISABLE"
+}
+
+func buildTransferInfo(d *schema.ResourceData) transferInfo {
+	return transferInfo{
+		Type:          "OBS",
+		Mode:          "cycle",
+		StorageFormat: d.Get("storage_format").(string),
+		Status:        transferStatus(d.Get("enabled").(bool)),
+		Detail: transferDetail{
+			ObsPeriod:        d.Get("period").(int),
+			ObsPeriodUnit:    d.Get("period_unit").(string),
+			ObsBucketName:    d.Get("obs_bucket_name").(string),
+			ObsDirPrefixName: d.Get("dir_prefix_name").(string),
+			ObsPrefixName:    d.Get("prefix_name").(string),
+			ObsTimeZone:      d.Get("time_zone").(string),
+			ObsTimeZoneID:    d.Get("time_zone_id").(string),
+		},
+	}
+}
+
+func resourceTransferV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	rawTopics := d.Get("topic_ids").(*schema.Set).List()
+	streams := make([]transferStream, len(rawTopics))
+	for i, v := range rawTopics {
+		streams[i] = transferStream{ID: v.(string)}
+	}
+	info := buildTransferInfo(d)
+	log.Printf("[DEBUG] Create Options: %#v", info)
+
+	id, err := createTransfer(client, d.Get("group_id").(string), streams, info)
+	if err != nil {
+		return fmt.Errorf("Error creating log transfer: %s", err)
+	}
+	d.SetId(id)
+
+	return resourceTransferV2Read(d, meta)
+}
+
+func resourceTransferV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	logTransfer, err := getTransfer(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "Error getting log transfer")
+	}
+	log.Printf("[DEBUG] Retrieved log transfer %s: %#v", d.Id(), logTransfer)
+
+	topicIDs := make([]string, len(logTransfer.LogStreams))
+	for i, stream := range logTransfer.LogStreams {
+		topicIDs[i] = stream.ID
+	}
+	info := logTransfer.Info
+
+	mErr := multierror.Append(nil,
+		d.Set("group_id", logTransfer.LogGroupID),
+		d.Set("topic_ids", topicIDs),
+		d.Set("obs_bucket_name", info.Detail.ObsBucketName),
+		d.Set("period", info.Detail.ObsPeriod),
+		d.Set("period_unit", info.Detail.ObsPeriodUnit),
+		d.Set("storage_format", info.StorageFormat),
+		d.Set("enabled", info.Status == "ENABLE"),
+		d.Set("dir_prefix_name", info.Detail.ObsDirPrefixName),
+		d.Set("prefix_name", info.Detail.ObsPrefixName),
+		d.Set("time_zone", info.Detail.ObsTimeZone),
+		d.Set("time_zone_id", info.Detail.ObsTimeZoneID),
+		d.Set("mode", info.Mode),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceTransferV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	info := buildTransferInfo(d)
+	log.Printf("[DEBUG] Update Options: %#v", info)
+
+	if err := updateTransfer(client, d.Id(), info); err != nil {
+		return fmt.Errorf("Error updating log transfer %s: %s", d.Id(), err)
+	}
+
+	return resourceTransferV2Read(d, meta)
+}
+
+func resourceTransferV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.LtsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud LTS client: %s", err)
+	}
+
+	if err := deleteTransfer(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "Error deleting log transfer")
+	}
+
+	d.SetId("")
+	return nil
+}
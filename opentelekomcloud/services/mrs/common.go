@@ -0,0 +1,122 @@
+package mrs
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/mrs/v1/cluster"
+)
+
+const (
+	coreNodeGroup = "core_node_default_group"
+	taskNodeGroup = "task_node_default_group"
+)
+
+// NodeGroup describes a node group of MRS cluster
+type NodeGroup struct {
+	GroupName       string `json:"groupName"`
+	NodeNum         int    `json:"nodeNum"`
+	NodeSize        string `json:"nodeSize"`
+	DataVolumeType  string `json:"dataVolumeType"`
+	DataVolumeSize  int    `json:"dataVolumeSize"`
+	DataVolumeCount int    `json:"dataVolumeCount"`
+
+	AutoScalingPolicy *AutoScalingPolicy `json:"AutoScalingPolicy"`
+}
+
+// AutoScalingPolicy describes auto scaling policy of a node group
+type AutoScalingPolicy struct {
+	AutoScalingEnable bool                       `json:"auto_scaling_enable"`
+	MinCapacity       int                        `json:"min_capacity"`
+	MaxCapacity       int                        `json:"max_capacity"`
+	ResourcesPlans    []AutoScalingResourcesPlan `json:"resources_plans"`
+	Rules             []AutoScalingRule          `json:"rules"`
+}
+
+type AutoScalingResourcesPlan struct {
+	PeriodType  string `json:"period_type"`
+	StartTime   string `json:"start_time"`
+	EndTime     string `json:"end_time"`
+	MinCapacity int    `json:"min_capacity"`
+	MaxCapacity int    `json:"max_capacity"`
+}
+
+type AutoScalingRule struct {
+	Name              string             `json:"name"`
+	Description       string             `json:"description"`
+	AdjustmentType    string             `json:"adjustment_type"`
+	CoolDownMinutes   int                `json:"cool_down_minutes"`
+	ScalingAdjustment int                `json:"scaling_adjustment"`
+	Trigger           AutoScalingTrigger `json:"trigger"`
+}
+
+type AutoScalingTrigger struct {
+	MetricName         string `json:"metric_name"`
+	MetricValue        string `json:"metric_value"`
+	ComparisonOperator string `json:"comparison_operator"`
+	EvaluationPeriods  int    `json:"evaluation_periods"`
+}
+
+// TaskNodeInfo describes task nodes added to the cluster on the first scale out
+type TaskNodeInfo struct {
+	NodeSize        string `json:"node_size"`
+	DataVolumeType  string `json:"data_volume_type,omitempty"`
+	DataVolumeSize  int    `json:"data_volume_size,omitempty"`
+	DataVolumeCount int    `json:"data_volume_count,omitempty"`
+}
+
+// getTaskNodeGroups returns task node groups of the cluster, which are missing in cluster.Cluster
+func getTaskNodeGroups(client *golangsdk.ServiceClient, clusterID string) ([]NodeGroup, error) {
+	var res struct {
+		Cluster struct {
+			TaskNodeGroups []NodeGroup `json:"taskNodeGroups"`
+		} `json:"cluster"`
+	}
+	_, err := client.Get(client.ServiceURL("cluster_infos", clusterID), &res, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: cluster.RequestOpts.MoreHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Cluster.TaskNodeGroups, nil
+}
+
+// resizeCluster scales out or scales in the node group by `instances` nodes,
+// taskNodeInfo is required when task nodes are added for the first time
+func resizeCluster(client *golangsdk.ServiceClient, clusterID, scaleType, nodeGroup string, instances int, taskNodeInfo *TaskNodeInfo) error {
+	parameters := map[string]interface{}{
+		"order_id":   "",
+		"scale_type": scaleType,
+		"node_id":    "node_orderadd",
+		"instances":  instances,
+		"node_group": nodeGroup,
+	}
+	if taskNodeInfo != nil {
+		parameters["task_node_info"] = taskNodeInfo
+	}
+	body := map[string]interface{}{
+		"service_id": "",
+		"plan_id":    "",
+		"parameters": parameters,
+		"previous_values": map[string]interface{}{
+			"plan_id": "",
+		},
+	}
+	_, err := client.Put(client.ServiceURL("cluster_infos", clusterID), body, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: cluster.RequestOpts.MoreHeaders,
+	})
+	return err
+}
+
+// setAutoScalingPolicy configures auto scaling policy of the node group
+func setAutoScalingPolicy(client *golangsdk.ServiceClient, clusterID, nodeGroup string, policy map[string]interface{}) error {
+	body := map[string]interface{}{
+		"node_group":          nodeGroup,
+		"auto_scaling_policy": policy,
+	}
+	_, err := client.Post(client.ServiceURL("autoscaling-policy", clusterID), body, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: cluster.RequestOpts.MoreHeaders,
+	})
+	return err
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/mrs/v1/cluster"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/mrs/v1/tags"
@@ -30,6 +31,7 @@ func ResourceMRSClusterV1() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -57,9 +59,9 @@ func ResourceMRSClusterV1() *schema.Resource {
 				ForceNew: true,
 			},
 			"core_node_num": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
 			},
 			"core_node_size": {
 				Type:     schema.TypeString,
@@ -330,6 +332,50 @@ func ResourceMRSClusterV1() *schema.Resource {
 					},
 				},
 			},
+			"task_node_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_num": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateFunc:     validation.IntBetween(0, 500),
+							DiffSuppressFunc: suppressAutoScaledNodeNum,
+						},
+						"node_size": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"data_volume_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SATA", "SAS", "SSD",
+							}, false),
+						},
+						"data_volume_size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"data_volume_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"auto_scaling_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     autoScalingPolicySchema(),
+						},
+					},
+				},
+			},
 			"tags": {
 				Type:         schema.TypeMap,
 				Optional:     true,
@@ -615,6 +661,10 @@ func resourceClusterV1Create(d *schema.ResourceData, meta interface{}) error {
 			clusterCreate.ClusterID, err)
 	}
 
+	if err := updateMrsTaskNodeGroup(d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	// Set tags
 	if common.HasFilledOpt(d, "tags") {
 		tagmap := d.Get("tags").(map[string]interface{})
@@ -635,6 +685,23 @@ func resourceClusterV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud MRS client: %s", err)
 	}
 
+	if d.HasChange("core_node_num") {
+		oldNum, newNum := d.GetChange("core_node_num")
+		if err := scaleMrsNodeGroup(d, client, coreNodeGroup, oldNum.(int), newNum.(int), nil, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("task_node_groups") {
+		if err := updateMrsTaskNodeGroup(d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if !d.HasChange("tags") {
+		return resourceClusterV1Read(d, meta)
+	}
+
 	oldTags, err := tags.Get(client, d.Id()).Extract()
 	if err != nil {
 		return fmt.Errorf("Error fetching OpenTelekomCloud MRS cluster tags: %s", err)
@@ -773,6 +840,30 @@ func resourceClusterV1Read(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("bootstrap_scripts", scripts)
 
+	if groups := d.Get("task_node_groups").([]interface{}); len(groups) > 0 {
+		taskNodeGroups, err := getTaskNodeGroups(client, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error fetching OpenTelekomCloud MRS cluster task node groups: %s", err)
+		}
+		group := groups[0].(map[string]interface{})
+		configuredPolicy := len(group["auto_scaling_policy"].([]interface{})) > 0
+		group["node_num"] = 0
+		group["auto_scaling_policy"] = nil
+		for _, taskGroup := range taskNodeGroups {
+			if taskGroup.GroupName != taskNodeGroup {
+				continue
+			}
+			group["node_num"] = taskGroup.NodeNum
+			// disabled policy is left in place when `auto_scaling_policy` is removed
+			if policy := taskGroup.AutoScalingPolicy; policy != nil && (policy.AutoScalingEnable || configuredPolicy) {
+				group["auto_scaling_policy"] = flattenMrsAutoScalingPolicy(policy)
+			}
+		}
+		if err := d.Set("task_node_groups", []interface{}{group}); err != nil {
+			return fmt.Errorf("Error saving task node groups of OpenTelekomCloud MRS cluster (%s): %s", d.Id(), err)
+		}
+	}
+
 	// Set instance tags
 	Taglist, err := tags.Get(client, d.Id()).Extract()
 	if err != nil {
@@ -865,3 +956,295 @@ func setTagForMrs(d *schema.ResourceData, meta interface{}, instanceID string, t
 
 	return nil
 }
+
+func autoScalingPolicySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"min_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 500),
+			},
+			"max_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 500),
+			},
+			"resources_plans": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"period_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "daily",
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"min_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 500),
+						},
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 500),
+						},
+					},
+				},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"adjustment_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"scale_out", "scale_in",
+							}, false),
+						},
+						"cool_down_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 10080),
+						},
+						"scaling_adjustment": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"trigger": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"metric_value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"comparison_operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"LT", "GT", "LTOE", "GTOE",
+										}, false),
+									},
+									"evaluation_periods": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 288),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandMrsAutoScalingPolicy(raw map[string]interface{}) map[string]interface{} {
+	plansRaw := raw["resources_plans"].([]interface{})
+	plans := make([]map[string]interface{}, len(plansRaw))
+	for i, v := range plansRaw {
+		plan := v.(map[string]interface{})
+		plans[i] = map[string]interface{}{
+			"period_type":  plan["period_type"].(string),
+			"start_time":   plan["start_time"].(string),
+			"end_time":     plan["end_time"].(string),
+			"min_capacity": plan["min_capacity"].(int),
+			"max_capacity": plan["max_capacity"].(int),
+		}
+	}
+
+	rulesRaw := raw["rules"].([]interface{})
+	rules := make([]map[string]interface{}, len(rulesRaw))
+	for i, v := range rulesRaw {
+		rule := v.(map[string]interface{})
+		trigger := rule["trigger"].([]interface{})[0].(map[string]interface{})
+		triggerOpts := map[string]interface{}{
+			"metric_name":        trigger["metric_name"].(string),
+			"metric_value":       trigger["metric_value"].(string),
+			"evaluation_periods": trigger["evaluation_periods"].(int),
+		}
+		if operator := trigger["comparison_operator"].(string); operator != "" {
+			triggerOpts["comparison_operator"] = operator
+		}
+		rules[i] = map[string]interface{}{
+			"name":               rule["name"].(string),
+			"description":        rule["description"].(string),
+			"adjustment_type":    rule["adjustment_type"].(string),
+			"cool_down_minutes":  rule["cool_down_minutes"].(int),
+			"scaling_adjustment": rule["scaling_adjustment"].(int),
+			"trigger":            triggerOpts,
+		}
+	}
+
+	return map[string]interface{}{
+		"auto_scaling_enable": raw["enabled"].(bool),
+		"min_capacity":        raw["min_capacity"].(int),
+		"max_capacity":        raw["max_capacity"].(int),
+		"resources_plans":     plans,
+		"rules":               rules,
+	}
+}
+
+func flattenMrsAutoScalingPolicy(policy *AutoScalingPolicy) []interface{} {
+	plans := make([]interface{}, len(policy.ResourcesPlans))
+	for i, plan := range policy.ResourcesPlans {
+		plans[i] = map[string]interface{}{
+			"period_type":  plan.PeriodType,
+			"start_time":   plan.StartTime,
+			"end_time":     plan.EndTime,
+			"min_capacity": plan.MinCapacity,
+			"max_capacity": plan.MaxCapacity,
+		}
+	}
+
+	rules := make([]interface{}, len(policy.Rules))
+	for i, rule := range policy.Rules {
+		rules[i] = map[string]interface{}{
+			"name":               rule.Name,
+			"description":        rule.Description,
+			"adjustment_type":    rule.AdjustmentType,
+			"cool_down_minutes":  rule.CoolDownMinutes,
+			"scaling_adjustment": rule.ScalingAdjustment,
+			"trigger": []interface{}{
+				map[string]interface{}{
+					"metric_name":         rule.Trigger.MetricName,
+					"metric_value":        rule.Trigger.MetricValue,
+					"comparison_operator": rule.Trigger.ComparisonOperator,
+					"evaluation_periods":  rule.Trigger.EvaluationPeriods,
+				},
+			},
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":         policy.AutoScalingEnable,
+			"min_capacity":    policy.MinCapacity,
+			"max_capacity":    policy.MaxCapacity,
+			"resources_plans": plans,
+			"rules":           rules,
+		},
+	}
+}
+
+// suppressAutoScaledNodeNum ignores changes of task node number made by enabled auto scaling policy,
+// the number is still applied when task nodes are added for the first time
+func suppressAutoScaledNodeNum(_, old, _ string, d *schema.ResourceData) bool {
+	if old == "" || old == "0" {
+		return false
+	}
+	return d.Get("task_node_groups.0.auto_scaling_policy.0.enabled").(bool)
+}
+
+// scaleMrsNodeGroup resizes the node group from oldNum to newNum nodes and waits for the cluster to be running
+func scaleMrsNodeGroup(d *schema.ResourceData, client *golangsdk.ServiceClient, nodeGroup string, oldNum, newNum int, taskNodeInfo *TaskNodeInfo, timeout time.Duration) error {
+	if oldNum == newNum {
+		return nil
+	}
+	scaleType := "scale_out"
+	instances := newNum - oldNum
+	if instances < 0 {
+		scaleType = "scale_in"
+		instances = -instances
+	}
+
+	log.Printf("[DEBUG] MRS cluster %s: %s of %s by %d nodes", d.Id(), scaleType, nodeGroup, instances)
+	if err := resizeCluster(client, d.Id(), scaleType, nodeGroup, instances, taskNodeInfo); err != nil {
+		return fmt.Errorf("Error resizing %s of MRS cluster %s: %s", nodeGroup, d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"scaling-out", "scaling-in"},
+		Target:     []string{"running"},
+		Refresh:    ClusterStateRefreshFunc(client, d.Id()),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for MRS cluster (%s) to be resized: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// updateMrsTaskNodeGroup applies task node number and auto scaling policy of the task node group
+func updateMrsTaskNodeGroup(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) error {
+	oldGroups, newGroups := d.GetChange("task_node_groups")
+
+	oldNum := 0
+	if groups := oldGroups.([]interface{}); len(groups) > 0 {
+		oldNum = groups[0].(map[string]interface{})["node_num"].(int)
+	}
+	newNum := 0
+	var group map[string]interface{}
+	if groups := newGroups.([]interface{}); len(groups) > 0 {
+		group = groups[0].(map[string]interface{})
+		newNum = group["node_num"].(int)
+	}
+
+	var taskNodeInfo *TaskNodeInfo
+	if oldNum == 0 && group != nil {
+		taskNodeInfo = &TaskNodeInfo{
+			NodeSize:        group["node_size"].(string),
+			DataVolumeType:  group["data_volume_type"].(string),
+			DataVolumeSize:  group["data_volume_size"].(int),
+			DataVolumeCount: group["data_volume_count"].(int),
+		}
+	}
+	if err := scaleMrsNodeGroup(d, client, taskNodeGroup, oldNum, newNum, taskNodeInfo, timeout); err != nil {
+		return err
+	}
+
+	if d.HasChange("task_node_groups.0.auto_scaling_policy") {
+		var policy map[string]interface{}
+		oldPolicies, newPolicies := d.GetChange("task_node_groups.0.auto_scaling_policy")
+		if policies := newPolicies.([]interface{}); len(policies) > 0 {
+			policy = expandMrsAutoScalingPolicy(policies[0].(map[string]interface{}))
+		} else if policies := oldPolicies.([]interface{}); len(policies) > 0 {
+			// removed policy is disabled, it can't be deleted
+			policy = expandMrsAutoScalingPolicy(policies[0].(map[string]interface{}))
+			policy["auto_scaling_enable"] = false
+		}
+		if policy != nil {
+			log.Printf("[DEBUG] MRS task node auto scaling policy: %#v", policy)
+			if err := setAutoScalingPolicy(client, d.Id(), taskNodeGroup, policy); err != nil {
+				return fmt.Errorf("Error setting auto scaling policy of MRS cluster %s: %s", d.Id(), err)
+			}
+		}
+	}
+	return nil
+}
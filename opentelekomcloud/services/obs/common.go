@@ -0,0 +1,474 @@
+package obs
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	subResourceEncryption  = "encryption"
+	subResourceReplication = "replication"
+)
+
+// ServerSideEncryptionConfiguration is the default encryption of the bucket objects
+type ServerSideEncryptionConfiguration struct {
+	XMLName xml.Name                     `xml:"ServerSideEncryptionConfiguration"`
+	Rule    ServerSideEncryptionRuleType `xml:"Rule"`
+}
+
+type ServerSideEncryptionRuleType struct {
+	ApplyServerSideEncryptionByDefault ServerSideEncryptionByDefault `xml:"ApplyServerSideEncryptionByDefault"`
+}
+
+type ServerSideEncryptionByDefault struct {
+	SSEAlgorithm   string `xml:"SSEAlgorithm"`
+	KMSMasterKeyID string `xml:"KMSMasterKeyID,omitempty"`
+}
+
+// ReplicationConfiguration describes cross-region replication of the bucket objects
+type ReplicationConfiguration struct {
+	XMLName xml.Name          `xml:"ReplicationConfiguration"`
+	Agency  string            `xml:"Role"`
+	Rules   []ReplicationRule `xml:"Rule"`
+}
+
+type ReplicationRule struct {
+	ID          string                 `xml:"ID,omitempty"`
+	Status      string                 `xml:"Status"`
+	Prefix      string                 `xml:"Prefix"`
+	Destination ReplicationDestination `xml:"Destination"`
+}
+
+type ReplicationDestination struct {
+	Bucket       string `xml:"Bucket"`
+	StorageClass string `xml:"StorageClass,omitempty"`
+}
+
+// subResourceClient sends requests to the bucket sub-resources missing in OBS SDK.
+// OBS client has to use V4 signature, as V2 signature skips unknown sub-resources.
+type subResourceClient struct {
+	obsClient  *obs.ObsClient
+	httpClient *http.Client
+}
+
+// newSubResourceClient creates client signing requests with V4 signature required by the sub-resources
+// and sending them with the provider HTTP client, which respects TLS, proxy, retry and debug settings
+func newSubResourceClient(config *cfg.Config, region string) (*subResourceClient, error) {
+	obsClient, err := config.NewObjectStorageClientWithSignature(region, obs.SignatureV4)
+	if err != nil {
+		return nil, err
+	}
+	return &subResourceClient{
+		obsClient:  obsClient,
+		httpClient: &config.HwClient.HTTPClient,
+	}, nil
+}
+
+func (c *subResourceClient) do(method obs.HttpMethodType, bucket, subResource string, body, out interface{}) error {
+	var data []byte
+	headers := make(map[string]string)
+	if body != nil {
+		var err error
+		data, err = xml.Marshal(body)
+		if err != nil {
+			return err
+		}
+		sum := md5.Sum(data)
+		headers["Content-MD5"] = base64.StdEncoding.EncodeToString(sum[:])
+		headers["Content-Type"] = "application/xml"
+	}
+
+	signed, err := c.obsClient.CreateSignedUrl(&obs.CreateSignedUrlInput{
+		Method:      method,
+		Bucket:      bucket,
+		SubResource: obs.SubResourceType(subResource),
+		Headers:     headers,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(string(method), signed.SignedUrl, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header = signed.ActualSignedRequestHeaders
+	if host, ok := req.Header["Host"]; ok {
+		req.Host = host[0]
+		delete(req.Header, "Host")
+	}
+	log.Printf("[DEBUG] %s %s of OBS bucket %s: %s", method, subResource, bucket, data)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 300 {
+		obsError := obs.ObsError{Status: resp.Status}
+		obsError.StatusCode = resp.StatusCode
+		if err := xml.Unmarshal(respBody, &obsError); err != nil {
+			obsError.Message = string(respBody)
+		}
+		return obsError
+	}
+
+	if out != nil {
+		if err := xml.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("error parsing %s of OBS bucket %s: %s", subResource, bucket, err)
+		}
+	}
+	return nil
+}
+
+func getBucketEncryption(client *subResourceClient, bucket string) (*ServerSideEncryptionConfiguration, error) {
+	encryption := &ServerSideEncryptionConfiguration{}
+	err := client.do(obs.HttpMethodGet, bucket, subResourceEncryption, nil, encryption)
+	return encryption, err
+}
+
+func setBucketEncryption(client *subResourceClient, bucket string, encryption *ServerSideEncryptionConfiguration) error {
+	return client.do(obs.HttpMethodPut, bucket, subResourceEncryption, encryption, nil)
+}
+
+func deleteBucketEncryption(client *subResourceClient, bucket string) error {
+	return client.do(obs.HttpMethodDelete, bucket, subResourceEncryption, nil, nil)
+}
+
+func getBucketReplication(client *subResourceClient, bucket string) (*ReplicationConfiguration, error) {
+	replication := &ReplicationConfiguration{}
+	err := client.do(obs.HttpMethodGet, bucket, subResourceReplication, nil, replication)
+	return replication, err
+}
+
+func setBucketReplication(client *subResourceClient, bucket string, replication *ReplicationConfiguration) error {
+	return client.do(obs.HttpMethodPut, bucket, subResourceReplication, replication, nil)
+}
+
+func deleteBucketReplication(client *subResourceClient, bucket string) error {
+	return client.do(obs.HttpMethodDelete, bucket, subResourceReplication, nil, nil)
+}
+
+func isObsNotFound(err error) bool {
+	obsError, ok := err.(obs.ObsError)
+	return ok && obsError.StatusCode == 404
+}
+
+// workerPool runs jobs with a fixed number of concurrent goroutines and collects all errors
+type workerPool struct {
+	jobs chan func() error
+	wg   sync.WaitGroup
+	mu   sync.Mutex
+	mErr *multierror.Error
+}
+
+func newWorkerPool(size int) *workerPool {
+	w := &workerPool{
+		jobs: make(chan func() error),
+	}
+	for i := 0; i < size; i++ {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			for job := range w.jobs {
+				if err := job(); err != nil {
+					w.mu.Lock()
+					w.mErr = multierror.Append(w.mErr, err)
+					w.mu.Unlock()
+				}
+			}
+		}()
+	}
+	return w
+}
+
+func (w *workerPool) failed() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.mErr != nil
+}
+
+func (w *workerPool) wait() error {
+	close(w.jobs)
+	w.wg.Wait()
+	return w.mErr.ErrorOrNil()
+}
+
+const (
+	// obsMaxParts is the maximum number of parts in a multipart upload
+	obsMaxParts = 10000
+	// obsPartRetries is the number of attempts to upload a single part
+	obsPartRetries = 3
+)
+
+// multipartUploadOpts describes upload of a local file using multipart upload
+type multipartUploadOpts struct {
+	obs.InitiateMultipartUploadInput
+	SourceFile  string
+	PartSize    int64
+	Concurrency int
+	// Restart aborts incomplete upload of the key instead of resuming it,
+	// e.g. when ACL, metadata or encryption of the object are changed
+	Restart bool
+}
+
+// uploadFileMultipart uploads the file in parts concurrently, each part is verified with its MD5.
+// Incomplete upload of the same key is resumed: already uploaded parts with matching size
+// and MD5 are skipped, failed uploads are not aborted to be resumed on the next run.
+func uploadFileMultipart(client *obs.ObsClient, opts multipartUploadOpts) (string, error) {
+	file, err := os.Open(opts.SourceFile)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	size := info.Size()
+	partSize := opts.PartSize
+	if size > partSize*obsMaxParts {
+		partSize = (size + obsMaxParts - 1) / obsMaxParts
+		log.Printf("[DEBUG] part size is increased to %d bytes to fit into %d parts", partSize, obsMaxParts)
+	}
+	partCount := int((size + partSize - 1) / partSize)
+
+	bucket, key := opts.Bucket, opts.Key
+	uploadID, uploaded, err := findMultipartUpload(client, bucket, key)
+	if err != nil {
+		return "", err
+	}
+	if uploadID != "" && opts.Restart {
+		log.Printf("[DEBUG] aborting multipart upload %s of %s started with different options", uploadID, key)
+		_, err := client.AbortMultipartUpload(&obs.AbortMultipartUploadInput{
+			Bucket:   bucket,
+			Key:      key,
+			UploadId: uploadID,
+		})
+		if err != nil && !isObsNotFound(err) {
+			return "", err
+		}
+		uploadID, uploaded = "", nil
+	}
+	if uploadID == "" {
+		output, err := client.InitiateMultipartUpload(&opts.InitiateMultipartUploadInput)
+		if err != nil {
+			return "", err
+		}
+		uploadID = output.UploadId
+	} else {
+		log.Printf("[DEBUG] resuming multipart upload %s of %s, %d parts uploaded", uploadID, key, len(uploaded))
+	}
+
+	parts := make([]obs.Part, partCount)
+	workers := newWorkerPool(opts.Concurrency)
+	for i := 0; i < partCount; i++ {
+		if workers.failed() {
+			break
+		}
+		partNumber := i + 1
+		offset := int64(i) * partSize
+		length := partSize
+		if offset+length > size {
+			length = size - offset
+		}
+		workers.jobs <- func() error {
+			// parts are streamed from the file, so memory usage doesn't depend on part size
+			hash := md5.New()
+			if _, err := io.Copy(hash, io.NewSectionReader(file, offset, length)); err != nil {
+				return fmt.Errorf("error reading part %d of %s: %s", partNumber, opts.SourceFile, err)
+			}
+			sum := hash.Sum(nil)
+			etag := hex.EncodeToString(sum)
+			if part, ok := uploaded[partNumber]; ok && part.Size == length && strings.Trim(part.ETag, `"`) == etag {
+				parts[partNumber-1] = obs.Part{PartNumber: partNumber, ETag: part.ETag}
+				return nil
+			}
+
+			var output *obs.UploadPartOutput
+			var err error
+			for attempt := 1; attempt <= obsPartRetries; attempt++ {
+				output, err = client.UploadPart(&obs.UploadPartInput{
+					Bucket:     bucket,
+					Key:        key,
+					UploadId:   uploadID,
+					PartNumber: partNumber,
+					ContentMD5: base64.StdEncoding.EncodeToString(sum),
+					Body:       io.NewSectionReader(file, offset, length),
+					PartSize:   length,
+				})
+				if err == nil {
+					break
+				}
+				log.Printf("[WARN] error uploading part %d of %s, attempt %d: %s", partNumber, key, attempt, err)
+			}
+			if err != nil {
+				return GetObsError(fmt.Sprintf("error uploading part %d of %s to OBS bucket", partNumber, key), bucket, err)
+			}
+			parts[partNumber-1] = obs.Part{PartNumber: partNumber, ETag: output.ETag}
+			log.Printf("[DEBUG] part %d/%d of %s uploaded", partNumber, partCount, key)
+			return nil
+		}
+	}
+	if err := workers.wait(); err != nil {
+		return "", fmt.Errorf("multipart upload %s is incomplete and will be resumed on the next apply: %s", uploadID, err)
+	}
+
+	output, err := client.CompleteMultipartUpload(&obs.CompleteMultipartUploadInput{
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: uploadID,
+		Parts:    parts,
+	})
+	if err != nil {
+		return "", err
+	}
+	return output.VersionId, nil
+}
+
+// findMultipartUpload returns the latest incomplete upload of the key with its uploaded parts
+func findMultipartUpload(client *obs.ObsClient, bucket, key string) (string, map[int]obs.Part, error) {
+	var latest *obs.Upload
+	uploadOpts := &obs.ListMultipartUploadsInput{
+		Bucket: bucket,
+		Prefix: key,
+	}
+	for {
+		uploads, err := client.ListMultipartUploads(uploadOpts)
+		if err != nil {
+			return "", nil, err
+		}
+		for i, upload := range uploads.Uploads {
+			if upload.Key == key && (latest == nil || upload.Initiated.After(latest.Initiated)) {
+				latest = &uploads.Uploads[i]
+			}
+		}
+		if !uploads.IsTruncated {
+			break
+		}
+		uploadOpts.KeyMarker = uploads.NextKeyMarker
+		uploadOpts.UploadIdMarker = uploads.NextUploadIdMarker
+	}
+	if latest == nil {
+		return "", nil, nil
+	}
+
+	parts := make(map[int]obs.Part)
+	listOpts := &obs.ListPartsInput{
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: latest.UploadId,
+	}
+	for {
+		output, err := client.ListParts(listOpts)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, part := range output.Parts {
+			parts[part.PartNumber] = part
+		}
+		if !output.IsTruncated {
+			break
+		}
+		listOpts.PartNumberMarker = output.NextPartNumberMarker
+	}
+	return latest.UploadId, parts, nil
+}
+
+// fileMD5 returns hex encoded MD5 of the file content
+func fileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// listAllObjects lists objects and common prefixes of the bucket page by page,
+// `limit` restricts the number of returned objects when positive
+func listAllObjects(client *obs.ObsClient, bucket, prefix, delimiter string, limit int) ([]obs.Content, []string, error) {
+	var objects []obs.Content
+	var prefixes []string
+
+	input := &obs.ListObjectsInput{
+		Bucket: bucket,
+	}
+	input.Prefix = prefix
+	input.Delimiter = delimiter
+	input.MaxKeys = obsMaxDeleteObjects
+	for {
+		output, err := client.ListObjects(input)
+		if err != nil {
+			return nil, nil, err
+		}
+		objects = append(objects, output.Contents...)
+		prefixes = append(prefixes, output.CommonPrefixes...)
+		if limit > 0 && len(objects) >= limit {
+			return objects[:limit], prefixes, nil
+		}
+		if !output.IsTruncated {
+			break
+		}
+		input.Marker = output.NextMarker
+		if input.Marker == "" && len(output.Contents) > 0 {
+			input.Marker = output.Contents[len(output.Contents)-1].Key
+		}
+	}
+	return objects, prefixes, nil
+}
+
+// deleteObjects deletes objects with given keys in batches
+func deleteObjects(client *obs.ObsClient, bucket string, keys []string) error {
+	for len(keys) > 0 {
+		size := obsMaxDeleteObjects
+		if len(keys) < size {
+			size = len(keys)
+		}
+		objects := make([]obs.ObjectToDelete, size)
+		for i, key := range keys[:size] {
+			objects[i] = obs.ObjectToDelete{Key: key}
+		}
+		keys = keys[size:]
+
+		output, err := client.DeleteObjects(&obs.DeleteObjectsInput{
+			Bucket:  bucket,
+			Quiet:   true,
+			Objects: objects,
+		})
+		if err != nil {
+			return err
+		}
+		if len(output.Errors) > 0 {
+			return fmt.Errorf("error some objects are still exist in %s: %#v", bucket, output.Errors)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package obs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceObsBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObsBucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"common_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceObsBucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	delimiter := d.Get("delimiter").(string)
+
+	contents, commonPrefixes, err := listAllObjects(client, bucket, prefix, delimiter, d.Get("max_keys").(int))
+	if err != nil {
+		return GetObsError("error listing objects of OBS bucket", bucket, err)
+	}
+
+	keys := make([]string, len(contents))
+	objects := make([]map[string]interface{}, len(contents))
+	for i, object := range contents {
+		keys[i] = object.Key
+		objects[i] = map[string]interface{}{
+			"key":           object.Key,
+			"etag":          strings.Trim(object.ETag, `"`),
+			"size":          int(object.Size),
+			"storage_class": normalizeStorageClass(string(object.StorageClass)),
+			"last_modified": object.LastModified.Format(time.RFC1123),
+			"owner":         object.Owner.ID,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", bucket, hashcode.String(prefix+"|"+delimiter)))
+
+	mErr := multierror.Append(
+		d.Set("keys", keys),
+		d.Set("common_prefixes", commonPrefixes),
+		d.Set("objects", objects),
+	)
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,328 @@
+package obs
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	// importFromS3 is the import ID suffix used for migration from `opentelekomcloud_s3_bucket`
+	importFromS3 = "/s3"
+	// importAllowUnsupported is the import ID suffix skipping the check of settings
+	// which can't be represented by `opentelekomcloud_obs_bucket`
+	importAllowUnsupported = "/allow-unsupported"
+)
+
+// resourceObsBucketImportState imports the bucket using its name.
+// With `/s3` suffix the bucket is imported together with its policy as `opentelekomcloud_obs_bucket_policy`,
+// so buckets managed by `opentelekomcloud_s3_bucket` can be moved to `opentelekomcloud_obs_bucket`
+// without changing them. Such import fails when the bucket has settings which would be lost on the next apply.
+func resourceObsBucketImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	allowUnsupported := strings.HasSuffix(id, importAllowUnsupported)
+	id = strings.TrimSuffix(id, importAllowUnsupported)
+	fromS3 := strings.HasSuffix(id, importFromS3)
+	bucket := strings.TrimSuffix(id, importFromS3)
+	if allowUnsupported && !fromS3 {
+		return nil, fmt.Errorf("invalid import ID %q, `%s` can be used only with `%s`, e.g. `%s%s%s`",
+			d.Id(), importAllowUnsupported, importFromS3, bucket, importFromS3, importAllowUnsupported)
+	}
+	d.SetId(bucket)
+
+	results := []*schema.ResourceData{d}
+	if !fromS3 {
+		return results, nil
+	}
+
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS client: %s", err)
+	}
+	s3conn, err := config.S3Client(config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	unsupported, err := unsupportedBucketSettings(s3conn, client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if len(unsupported) > 0 {
+		if !allowUnsupported {
+			return nil, fmt.Errorf("OBS bucket %s has settings which can't be represented by opentelekomcloud_obs_bucket:\n"+
+				"  * %s\nuse %q as import ID to import it anyway, these settings will be lost on the next update",
+				bucket, strings.Join(unsupported, "\n  * "), bucket+importFromS3+importAllowUnsupported)
+		}
+		log.Printf("[WARN] settings of OBS bucket %s which will be lost on the next update: %v", bucket, unsupported)
+	}
+
+	output, err := client.GetBucketPolicy(bucket)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			// bucket without policy
+			return results, nil
+		}
+		return nil, GetObsError("error importing policy of OBS bucket", bucket, err)
+	}
+
+	policy := ResourceObsBucketPolicy().Data(nil)
+	policy.SetId(bucket)
+	policy.SetType("opentelekomcloud_obs_bucket_policy")
+	if err := policy.Set("bucket", bucket); err != nil {
+		return nil, err
+	}
+	if err := policy.Set("policy", output.Policy); err != nil {
+		return nil, err
+	}
+	results = append(results, policy)
+
+	return results, nil
+}
+
+// unsupportedBucketSettings lists settings of the bucket available in `opentelekomcloud_s3_bucket`
+// which have no equivalent in `opentelekomcloud_obs_bucket`
+func unsupportedBucketSettings(conn *s3.S3, client *obs.ObsClient, bucket string) ([]string, error) {
+	var unsupported []string
+	for _, check := range []func(*s3.S3, *obs.ObsClient, string) ([]string, error){
+		unsupportedVersioningSettings,
+		unsupportedLifecycleSettings,
+		unsupportedWebsiteSettings,
+		unsupportedCorsSettings,
+		unsupportedACLSettings,
+	} {
+		settings, err := check(conn, client, bucket)
+		if err != nil {
+			return nil, err
+		}
+		unsupported = append(unsupported, settings...)
+	}
+	log.Printf("[DEBUG] unsupported settings of OBS bucket %s: %v", bucket, unsupported)
+
+	return unsupported, nil
+}
+
+func isAwsErrCode(err error, code string) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == code
+}
+
+func unsupportedVersioningSettings(conn *s3.S3, _ *obs.ObsClient, bucket string) ([]string, error) {
+	versioning, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting versioning of OBS bucket %s: %s", bucket, err)
+	}
+	if aws.StringValue(versioning.MFADelete) == s3.MFADeleteStatusEnabled {
+		return []string{"versioning.mfa_delete"}, nil
+	}
+	return nil, nil
+}
+
+func unsupportedLifecycleSettings(conn *s3.S3, client *obs.ObsClient, bucket string) ([]string, error) {
+	lifecycle, err := conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if isAwsErrCode(err, "NoSuchLifecycleConfiguration") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting lifecycle configuration of OBS bucket %s: %s", bucket, err)
+	}
+	obsLifecycle, err := client.GetBucketLifecycleConfiguration(bucket)
+	if err != nil {
+		return nil, GetObsError("error getting lifecycle configuration of OBS bucket", bucket, err)
+	}
+	obsRules := make(map[string]map[string]interface{})
+	for _, rule := range obsLifecycle.LifecycleRules {
+		obsRules[rule.ID] = ruleToMap(rule)
+	}
+
+	var unsupported []string
+	for _, rule := range lifecycle.Rules {
+		id := aws.StringValue(rule.ID)
+		if rule.AbortIncompleteMultipartUpload != nil {
+			unsupported = append(unsupported, fmt.Sprintf("lifecycle_rule %q: abort_incomplete_multipart_upload_days", id))
+		}
+		if rule.Filter != nil && (rule.Filter.Tag != nil || rule.Filter.And != nil && len(rule.Filter.And.Tags) > 0) {
+			unsupported = append(unsupported, fmt.Sprintf("lifecycle_rule %q: tags", id))
+		}
+		if rule.Expiration != nil {
+			if rule.Expiration.Date != nil {
+				unsupported = append(unsupported, fmt.Sprintf("lifecycle_rule %q: expiration.date", id))
+			}
+			if aws.BoolValue(rule.Expiration.ExpiredObjectDeleteMarker) {
+				unsupported = append(unsupported, fmt.Sprintf("lifecycle_rule %q: expiration.expired_object_delete_marker", id))
+			}
+		}
+		for _, transition := range rule.Transitions {
+			if transition.Date != nil {
+				unsupported = append(unsupported, fmt.Sprintf("lifecycle_rule %q: transition.date", id))
+			}
+		}
+		if rule.NoncurrentVersionExpiration != nil {
+			// noncurrent version expiration is read only when it has days
+			days := int(aws.Int64Value(rule.NoncurrentVersionExpiration.NoncurrentDays))
+			expected := []interface{}{map[string]interface{}{"days": days}}
+			actual, ok := obsRules[id]["noncurrent_version_expiration"].(*schema.Set)
+			if !ok || !reflect.DeepEqual(actual.List(), expected) {
+				unsupported = append(unsupported, fmt.Sprintf("lifecycle_rule %q: noncurrent_version_expiration", id))
+			}
+		}
+	}
+	return unsupported, nil
+}
+
+func unsupportedWebsiteSettings(conn *s3.S3, client *obs.ObsClient, bucket string) ([]string, error) {
+	website, err := conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if isAwsErrCode(err, "NoSuchWebsiteConfiguration") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting website configuration of OBS bucket %s: %s", bucket, err)
+	}
+	obsWebsite, err := client.GetBucketWebsiteConfiguration(bucket)
+	if err != nil {
+		return nil, GetObsError("error getting website configuration of OBS bucket", bucket, err)
+	}
+
+	var unsupported []string
+	if redirect := website.RedirectAllRequestsTo; redirect != nil {
+		obsRedirect := obsWebsite.RedirectAllRequestsTo
+		if aws.StringValue(redirect.HostName) != obsRedirect.HostName ||
+			aws.StringValue(redirect.Protocol) != string(obsRedirect.Protocol) {
+			unsupported = append(unsupported, "website.redirect_all_requests_to")
+		}
+	}
+
+	// every field of the routing rule has to be kept by OBS
+	equal := len(website.RoutingRules) == len(obsWebsite.RoutingRules)
+	for i := 0; equal && i < len(website.RoutingRules); i++ {
+		rule, obsRule := website.RoutingRules[i], obsWebsite.RoutingRules[i]
+		condition := rule.Condition
+		if condition == nil {
+			condition = &s3.Condition{}
+		}
+		redirect := rule.Redirect
+		if redirect == nil {
+			redirect = &s3.Redirect{}
+		}
+		equal = aws.StringValue(condition.KeyPrefixEquals) == obsRule.Condition.KeyPrefixEquals &&
+			aws.StringValue(condition.HttpErrorCodeReturnedEquals) == obsRule.Condition.HttpErrorCodeReturnedEquals &&
+			aws.StringValue(redirect.Protocol) == string(obsRule.Redirect.Protocol) &&
+			aws.StringValue(redirect.HostName) == obsRule.Redirect.HostName &&
+			aws.StringValue(redirect.ReplaceKeyPrefixWith) == obsRule.Redirect.ReplaceKeyPrefixWith &&
+			aws.StringValue(redirect.ReplaceKeyWith) == obsRule.Redirect.ReplaceKeyWith &&
+			aws.StringValue(redirect.HttpRedirectCode) == obsRule.Redirect.HttpRedirectCode
+	}
+	if !equal {
+		unsupported = append(unsupported, "website.routing_rules")
+	}
+	return unsupported, nil
+}
+
+func unsupportedCorsSettings(conn *s3.S3, client *obs.ObsClient, bucket string) ([]string, error) {
+	cors, err := conn.GetBucketCors(&s3.GetBucketCorsInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if isAwsErrCode(err, "NoSuchCORSConfiguration") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting CORS configuration of OBS bucket %s: %s", bucket, err)
+	}
+	obsCors, err := client.GetBucketCors(bucket)
+	if err != nil {
+		return nil, GetObsError("error getting CORS configuration of OBS bucket", bucket, err)
+	}
+
+	if len(cors.CORSRules) != len(obsCors.CorsRules) {
+		return []string{"cors_rule"}, nil
+	}
+	var unsupported []string
+	for i, rule := range cors.CORSRules {
+		if !equalStringSets(aws.StringValueSlice(rule.ExposeHeaders), obsCors.CorsRules[i].ExposeHeader) {
+			unsupported = append(unsupported, fmt.Sprintf("cors_rule %d: expose_headers", i))
+		}
+	}
+	return unsupported, nil
+}
+
+func equalStringSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]string{}, a...), append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return reflect.DeepEqual(a, b)
+}
+
+// unsupportedACLSettings checks that bucket ACL is one of the canned ACLs supported by `acl`
+func unsupportedACLSettings(conn *s3.S3, _ *obs.ObsClient, bucket string) ([]string, error) {
+	acl, err := conn.GetBucketAcl(&s3.GetBucketAclInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ACL of OBS bucket %s: %s", bucket, err)
+	}
+	canned := cannedBucketACL(acl)
+	if canned == "" {
+		return []string{"acl: grants not matching any canned ACL"}, nil
+	}
+	log.Printf("[INFO] ACL of OBS bucket %s matches `acl = %q`", bucket, canned)
+	return nil, nil
+}
+
+// cannedBucketACL returns canned ACL matching bucket grants or empty string if there is no such ACL
+func cannedBucketACL(acl *s3.GetBucketAclOutput) string {
+	var owner string
+	if acl.Owner != nil {
+		owner = aws.StringValue(acl.Owner.ID)
+	}
+	var groupGrants []string
+	for _, grant := range acl.Grants {
+		if grant.Grantee == nil {
+			return ""
+		}
+		permission := aws.StringValue(grant.Permission)
+		switch aws.StringValue(grant.Grantee.Type) {
+		case s3.TypeCanonicalUser:
+			if aws.StringValue(grant.Grantee.ID) != owner || permission != s3.PermissionFullControl {
+				return ""
+			}
+		case s3.TypeGroup:
+			groupGrants = append(groupGrants, path.Base(aws.StringValue(grant.Grantee.URI))+":"+permission)
+		default:
+			return ""
+		}
+	}
+
+	cannedACLs := map[string][]string{
+		"private":            nil,
+		"public-read":        {"AllUsers:READ"},
+		"public-read-write":  {"AllUsers:READ", "AllUsers:WRITE"},
+		"log-delivery-write": {"LogDelivery:READ_ACP", "LogDelivery:WRITE"},
+	}
+	for canned, grants := range cannedACLs {
+		if equalStringSets(grants, groupGrants) {
+			return canned
+		}
+	}
+	return ""
+}
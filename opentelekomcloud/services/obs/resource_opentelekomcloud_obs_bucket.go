@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
@@ -24,7 +27,11 @@ func ResourceObsBucket() *schema.Resource {
 		Update: resourceObsBucketUpdate,
 		Delete: resourceObsBucketDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceObsBucketImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -232,6 +239,132 @@ func ResourceObsBucket() *schema.Resource {
 				},
 			},
 
+			"server_side_encryption": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"kms", "AES256",
+							}, false),
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"replication_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agency": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"destination_bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"STANDARD", "WARM", "COLD",
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"event_notifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(obs.ObjectCreatedAll),
+									string(obs.ObjectCreatedPut),
+									string(obs.ObjectCreatedPost),
+									string(obs.ObjectCreatedCopy),
+									string(obs.ObjectCreatedCompleteMultipartUpload),
+									string(obs.ObjectRemovedAll),
+									string(obs.ObjectRemovedDelete),
+									string(obs.ObjectRemovedDeleteMarkerCreated),
+								}, false),
+							},
+						},
+						"filter_rule": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"prefix", "suffix",
+										}, false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -341,13 +474,38 @@ func resourceObsBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChanges("server_side_encryption", "replication_configuration") {
+		subClient, err := newSubResourceClient(config, config.GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("error creating OBS client: %s", err)
+		}
+
+		if d.HasChange("server_side_encryption") {
+			if err := resourceObsBucketEncryptionUpdate(subClient, d); err != nil {
+				return err
+			}
+		}
+
+		if d.HasChange("replication_configuration") {
+			if err := resourceObsBucketReplicationUpdate(subClient, d); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("event_notifications") {
+		if err := resourceObsBucketNotificationsUpdate(obsClient, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceObsBucketRead(d, meta)
 }
 
 func resourceObsBucketRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	region := config.GetRegion(d)
-	obsClient, err := config.NewObjectStorageClient(config.GetRegion(d))
+	obsClient, err := config.NewObjectStorageClient(region)
 	if err != nil {
 		return fmt.Errorf("error creating OBS client: %s", err)
 	}
@@ -409,6 +567,26 @@ func resourceObsBucketRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// Read the event notifications
+	if err := setObsBucketNotifications(obsClient, d); err != nil {
+		return err
+	}
+
+	subClient, err := newSubResourceClient(config, region)
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	// Read the encryption configuration
+	if err := setObsBucketEncryption(subClient, d); err != nil {
+		return err
+	}
+
+	// Read the replication configuration
+	if err := setObsBucketReplication(subClient, d); err != nil {
+		return err
+	}
+
 	// Read the tags
 	if err := setObsBucketTags(obsClient, d); err != nil {
 		return err
@@ -425,24 +603,29 @@ func resourceObsBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	bucket := d.Id()
-	log.Printf("[DEBUG] deleting OBS Bucket: %s", bucket)
-	_, err = obsClient.DeleteBucket(bucket)
-	if err != nil {
+	timeout := d.Timeout(schema.TimeoutDelete)
+	deadline := time.Now().Add(timeout)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		log.Printf("[DEBUG] deleting OBS Bucket: %s", bucket)
+		_, err := obsClient.DeleteBucket(bucket)
+		if err == nil {
+			return nil
+		}
 		obsError, ok := err.(obs.ObsError)
-		if ok && obsError.Code == "BucketNotEmpty" {
-			log.Printf("[WARN] OBS bucket: %s is not empty", bucket)
-			if d.Get("force_destroy").(bool) {
-				err = deleteAllBucketObjects(obsClient, bucket)
-				if err == nil {
-					log.Printf("[WARN] all objects of %s have been deleted, and try again", bucket)
-					return resourceObsBucketDelete(d, meta)
-				}
-			}
-			return err
+		if !ok || obsError.Code != "BucketNotEmpty" {
+			return resource.NonRetryableError(fmt.Errorf("error deleting OBS bucket: %s %s", bucket, err))
 		}
-		return fmt.Errorf("error deleting OBS bucket: %s %s", bucket, err)
-	}
-	return nil
+		log.Printf("[WARN] OBS bucket: %s is not empty", bucket)
+		if !d.Get("force_destroy").(bool) {
+			return resource.NonRetryableError(err)
+		}
+		if err := deleteAllBucketObjects(obsClient, bucket, deadline); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		log.Printf("[WARN] all objects of %s have been deleted, and try again", bucket)
+		return resource.RetryableError(err)
+	})
+	return err
 }
 
 func resourceObsBucketTagsUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
@@ -743,6 +926,105 @@ func resourceObsBucketCorsUpdate(obsClient *obs.ObsClient, d *schema.ResourceDat
 	return nil
 }
 
+func resourceObsBucketEncryptionUpdate(client *subResourceClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	rawEncryption := d.Get("server_side_encryption").([]interface{})
+	if len(rawEncryption) == 0 {
+		log.Printf("[DEBUG] OBS bucket: %s, delete encryption configuration", bucket)
+		if err := deleteBucketEncryption(client, bucket); err != nil && !isObsNotFound(err) {
+			return GetObsError("Error deleting encryption configuration of OBS bucket", bucket, err)
+		}
+		return nil
+	}
+
+	encryption := rawEncryption[0].(map[string]interface{})
+	algorithm := encryption["algorithm"].(string)
+	if algorithm == "kms" {
+		algorithm = "aws:kms"
+	}
+	opts := &ServerSideEncryptionConfiguration{}
+	opts.Rule.ApplyServerSideEncryptionByDefault = ServerSideEncryptionByDefault{
+		SSEAlgorithm:   algorithm,
+		KMSMasterKeyID: encryption["kms_key_id"].(string),
+	}
+	log.Printf("[DEBUG] set encryption configuration of OBS bucket %s: %#v", bucket, opts)
+
+	if err := setBucketEncryption(client, bucket, opts); err != nil {
+		return GetObsError("Error setting encryption configuration of OBS bucket", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketReplicationUpdate(client *subResourceClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	rawReplication := d.Get("replication_configuration").([]interface{})
+	if len(rawReplication) == 0 {
+		log.Printf("[DEBUG] OBS bucket: %s, delete replication configuration", bucket)
+		if err := deleteBucketReplication(client, bucket); err != nil && !isObsNotFound(err) {
+			return GetObsError("Error deleting replication configuration of OBS bucket", bucket, err)
+		}
+		return nil
+	}
+
+	replication := rawReplication[0].(map[string]interface{})
+	opts := &ReplicationConfiguration{
+		Agency: replication["agency"].(string),
+	}
+	for _, v := range replication["rule"].([]interface{}) {
+		rule := v.(map[string]interface{})
+		status := "Disabled"
+		if rule["enabled"].(bool) {
+			status = "Enabled"
+		}
+		opts.Rules = append(opts.Rules, ReplicationRule{
+			ID:     rule["id"].(string),
+			Status: status,
+			Prefix: rule["prefix"].(string),
+			Destination: ReplicationDestination{
+				Bucket:       rule["destination_bucket"].(string),
+				StorageClass: denormalizeStorageClass(rule["storage_class"].(string)),
+			},
+		})
+	}
+	log.Printf("[DEBUG] set replication configuration of OBS bucket %s: %#v", bucket, opts)
+
+	if err := setBucketReplication(client, bucket, opts); err != nil {
+		return GetObsError("Error setting replication configuration of OBS bucket", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketNotificationsUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	opts := &obs.SetBucketNotificationInput{
+		Bucket: bucket,
+	}
+	for _, v := range d.Get("event_notifications").([]interface{}) {
+		notification := v.(map[string]interface{})
+		topic := obs.TopicConfiguration{
+			ID:    notification["id"].(string),
+			Topic: notification["topic"].(string),
+		}
+		for _, event := range notification["events"].(*schema.Set).List() {
+			topic.Events = append(topic.Events, obs.EventType(event.(string)))
+		}
+		for _, f := range notification["filter_rule"].(*schema.Set).List() {
+			filter := f.(map[string]interface{})
+			topic.FilterRules = append(topic.FilterRules, obs.FilterRule{
+				Name:  filter["name"].(string),
+				Value: filter["value"].(string),
+			})
+		}
+		opts.TopicConfigurations = append(opts.TopicConfigurations, topic)
+	}
+	log.Printf("[DEBUG] set event notifications of OBS bucket %s: %#v", bucket, opts)
+
+	if _, err := obsClient.SetBucketNotification(opts); err != nil {
+		return GetObsError("Error setting event notifications of OBS bucket", bucket, err)
+	}
+	return nil
+}
+
 func resourceObsBucketWebsitePut(obsClient *obs.ObsClient, d *schema.ResourceData, website map[string]interface{}) error {
 	bucket := d.Get("bucket").(string)
 
@@ -1074,6 +1356,104 @@ func setObsBucketCorsRules(obsClient *obs.ObsClient, d *schema.ResourceData) err
 	return nil
 }
 
+func setObsBucketEncryption(client *subResourceClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := getBucketEncryption(client, bucket)
+	if err != nil {
+		if isObsNotFound(err) {
+			return d.Set("server_side_encryption", nil)
+		}
+		return GetObsError("Error getting encryption configuration of OBS bucket", bucket, err)
+	}
+
+	rule := output.Rule.ApplyServerSideEncryptionByDefault
+	algorithm := rule.SSEAlgorithm
+	if algorithm == "aws:kms" {
+		algorithm = "kms"
+	}
+	encryption := []map[string]interface{}{
+		{
+			"algorithm":  algorithm,
+			"kms_key_id": rule.KMSMasterKeyID,
+		},
+	}
+	log.Printf("[DEBUG] saving encryption configuration of OBS bucket: %s: %#v", bucket, encryption)
+
+	if err := d.Set("server_side_encryption", encryption); err != nil {
+		return fmt.Errorf("error saving encryption configuration of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func setObsBucketReplication(client *subResourceClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := getBucketReplication(client, bucket)
+	if err != nil {
+		if isObsNotFound(err) {
+			return d.Set("replication_configuration", nil)
+		}
+		return GetObsError("Error getting replication configuration of OBS bucket", bucket, err)
+	}
+
+	rules := make([]map[string]interface{}, len(output.Rules))
+	for i, rule := range output.Rules {
+		rules[i] = map[string]interface{}{
+			"id":                 rule.ID,
+			"prefix":             rule.Prefix,
+			"enabled":            rule.Status == "Enabled",
+			"destination_bucket": rule.Destination.Bucket,
+			"storage_class":      normalizeStorageClass(rule.Destination.StorageClass),
+		}
+	}
+	replication := []map[string]interface{}{
+		{
+			"agency": output.Agency,
+			"rule":   rules,
+		},
+	}
+	log.Printf("[DEBUG] saving replication configuration of OBS bucket: %s: %#v", bucket, replication)
+
+	if err := d.Set("replication_configuration", replication); err != nil {
+		return fmt.Errorf("error saving replication configuration of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func setObsBucketNotifications(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := obsClient.GetBucketNotification(bucket)
+	if err != nil {
+		return GetObsError("Error getting event notifications of OBS bucket", bucket, err)
+	}
+
+	notifications := make([]map[string]interface{}, len(output.TopicConfigurations))
+	for i, topic := range output.TopicConfigurations {
+		events := make([]string, len(topic.Events))
+		for j, event := range topic.Events {
+			events[j] = string(event)
+		}
+		filters := make([]map[string]interface{}, len(topic.FilterRules))
+		for j, filter := range topic.FilterRules {
+			filters[j] = map[string]interface{}{
+				"name":  filter.Name,
+				"value": filter.Value,
+			}
+		}
+		notifications[i] = map[string]interface{}{
+			"id":          topic.ID,
+			"topic":       topic.Topic,
+			"events":      events,
+			"filter_rule": filters,
+		}
+	}
+	log.Printf("[DEBUG] saving event notifications of OBS bucket: %s: %#v", bucket, notifications)
+
+	if err := d.Set("event_notifications", notifications); err != nil {
+		return fmt.Errorf("error saving event notifications of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
 func setObsBucketTags(obsClient *obs.ObsClient, d *schema.ResourceData) error {
 	bucket := d.Id()
 	output, err := obsClient.GetBucketTagging(bucket)
@@ -1101,34 +1481,146 @@ func setObsBucketTags(obsClient *obs.ObsClient, d *schema.ResourceData) error {
 	return nil
 }
 
-func deleteAllBucketObjects(obsClient *obs.ObsClient, bucket string) error {
-	listOpts := &obs.ListObjectsInput{
+const (
+	// obsMaxDeleteObjects is the maximum number of objects in a single DeleteObjects request
+	obsMaxDeleteObjects = 1000
+	// obsPurgeWorkers is the number of concurrent requests used to purge a bucket
+	obsPurgeWorkers = 10
+)
+
+// deleteAllBucketObjects aborts all multipart uploads and removes all objects of the bucket
+// including non-current versions and delete markers
+func deleteAllBucketObjects(obsClient *obs.ObsClient, bucket string, deadline time.Time) error {
+	if err := abortAllMultipartUploads(obsClient, bucket, deadline); err != nil {
+		return err
+	}
+	return deleteAllObjectVersions(obsClient, bucket, deadline)
+}
+
+func deleteAllObjectVersions(obsClient *obs.ObsClient, bucket string, deadline time.Time) error {
+	workers := newWorkerPool(obsPurgeWorkers)
+	var deleted int64
+
+	deleteBatch := func(objects []obs.ObjectToDelete) func() error {
+		return func() error {
+			output, err := obsClient.DeleteObjects(&obs.DeleteObjectsInput{
+				Bucket:  bucket,
+				Quiet:   true,
+				Objects: objects,
+			})
+			if err != nil {
+				return GetObsError("error deleting objects of OBS bucket", bucket, err)
+			}
+			if len(output.Errors) > 0 {
+				return fmt.Errorf("error some objects are still exist in %s: %#v", bucket, output.Errors)
+			}
+			total := atomic.AddInt64(&deleted, int64(len(objects)-len(output.Errors)))
+			log.Printf("[DEBUG] %d object versions of OBS bucket %s have been deleted", total, bucket)
+			return nil
+		}
+	}
+
+	listOpts := &obs.ListVersionsInput{
 		Bucket: bucket,
 	}
-	// list all objects
-	resp, err := obsClient.ListObjects(listOpts)
-	if err != nil {
-		return GetObsError("error listing objects of OBS bucket", bucket, err)
+	listOpts.MaxKeys = obsMaxDeleteObjects
+	for {
+		if time.Now().After(deadline) {
+			_ = workers.wait()
+			return fmt.Errorf("timeout while deleting objects of OBS bucket %s, %d object versions deleted", bucket, deleted)
+		}
+		if workers.failed() {
+			break
+		}
+
+		resp, err := obsClient.ListVersions(listOpts)
+		if err != nil {
+			_ = workers.wait()
+			return GetObsError("error listing object versions of OBS bucket", bucket, err)
+		}
+
+		objects := make([]obs.ObjectToDelete, 0, len(resp.Versions)+len(resp.DeleteMarkers))
+		for _, version := range resp.Versions {
+			objects = append(objects, obs.ObjectToDelete{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range resp.DeleteMarkers {
+			objects = append(objects, obs.ObjectToDelete{Key: marker.Key, VersionId: marker.VersionId})
+		}
+		for len(objects) > 0 {
+			size := obsMaxDeleteObjects
+			if len(objects) < size {
+				size = len(objects)
+			}
+			workers.jobs <- deleteBatch(objects[:size])
+			objects = objects[size:]
+		}
+
+		if !resp.IsTruncated {
+			break
+		}
+		listOpts.KeyMarker = resp.NextKeyMarker
+		listOpts.VersionIdMarker = resp.NextVersionIdMarker
 	}
 
-	objects := make([]obs.ObjectToDelete, len(resp.Contents))
-	for i, content := range resp.Contents {
-		objects[i].Key = content.Key
+	if err := workers.wait(); err != nil {
+		return err
 	}
+	log.Printf("[DEBUG] all %d object versions of OBS bucket %s have been deleted", deleted, bucket)
+	return nil
+}
 
-	deleteOpts := &obs.DeleteObjectsInput{
-		Bucket:  bucket,
-		Objects: objects,
+func abortAllMultipartUploads(obsClient *obs.ObsClient, bucket string, deadline time.Time) error {
+	workers := newWorkerPool(obsPurgeWorkers)
+	var aborted int64
+
+	listOpts := &obs.ListMultipartUploadsInput{
+		Bucket:     bucket,
+		MaxUploads: obsMaxDeleteObjects,
 	}
-	log.Printf("[DEBUG] objects of %s will be deleted: %v", bucket, objects)
-	output, err := obsClient.DeleteObjects(deleteOpts)
-	if err != nil {
-		return GetObsError("error deleting all objects of OBS bucket", bucket, err)
-	} else {
-		if len(output.Errors) > 0 {
-			return fmt.Errorf("error some objects are still exist in %s: %#v", bucket, output.Errors)
+	for {
+		if time.Now().After(deadline) {
+			_ = workers.wait()
+			return fmt.Errorf("timeout while aborting multipart uploads of OBS bucket %s", bucket)
+		}
+		if workers.failed() {
+			break
 		}
+
+		resp, err := obsClient.ListMultipartUploads(listOpts)
+		if err != nil {
+			_ = workers.wait()
+			return GetObsError("error listing multipart uploads of OBS bucket", bucket, err)
+		}
+
+		for _, upload := range resp.Uploads {
+			abortOpts := &obs.AbortMultipartUploadInput{
+				Bucket:   bucket,
+				Key:      upload.Key,
+				UploadId: upload.UploadId,
+			}
+			workers.jobs <- func() error {
+				if _, err := obsClient.AbortMultipartUpload(abortOpts); err != nil {
+					return GetObsError(fmt.Sprintf("error aborting multipart upload %s of OBS bucket", abortOpts.UploadId), bucket, err)
+				}
+				total := atomic.AddInt64(&aborted, 1)
+				if total%100 == 0 {
+					log.Printf("[DEBUG] %d multipart uploads of OBS bucket %s have been aborted", total, bucket)
+				}
+				return nil
+			}
+		}
+
+		if !resp.IsTruncated {
+			break
+		}
+		listOpts.KeyMarker = resp.NextKeyMarker
+		listOpts.UploadIdMarker = resp.NextUploadIdMarker
+	}
+
+	if err := workers.wait(); err != nil {
+		return err
 	}
+	log.Printf("[DEBUG] all %d multipart uploads of OBS bucket %s have been aborted", aborted, bucket)
 	return nil
 }
 
@@ -1139,6 +1631,17 @@ func GetObsError(action string, bucket string, err error) error {
 	return err
 }
 
+// denormalize storage class to the format used by S3 compatible API
+func denormalizeStorageClass(class string) string {
+	switch class {
+	case "WARM":
+		return "STANDARD_IA"
+	case "COLD":
+		return "GLACIER"
+	}
+	return class
+}
+
 // normalize format of storage class
 func normalizeStorageClass(class string) string {
 	var ret = class
@@ -0,0 +1,333 @@
+package obs
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceObsBucketDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObsBucketDirectoryCreate,
+		Read:   resourceObsBucketDirectoryRead,
+		Update: resourceObsBucketDirectoryUpdate,
+		Delete: resourceObsBucketDirectoryDelete,
+
+		CustomizeDiff: resourceObsBucketDirectoryFilesDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"acl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"private", "public-read", "public-read-write",
+				}, true),
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// directoryFile is a local file to be synced to the bucket
+type directoryFile struct {
+	Path string
+	MD5  string
+}
+
+// directoryPrefix returns `prefix` ending with `/`, so `static` doesn't match `static2/` objects
+func directoryPrefix(prefix string) string {
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		return prefix
+	}
+	return prefix + "/"
+}
+
+// scanDirectory returns regular files of the directory mapped by object keys
+func scanDirectory(source, prefix string) (map[string]directoryFile, error) {
+	prefix = directoryPrefix(prefix)
+	files := make(map[string]directoryFile)
+	err := filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		hash, err := fileMD5(path)
+		if err != nil {
+			return err
+		}
+		files[prefix+filepath.ToSlash(rel)] = directoryFile{Path: path, MD5: hash}
+		return nil
+	})
+	return files, err
+}
+
+// resourceObsBucketDirectoryFilesDiff plans sync when content of the source directory
+// differs from the files stored in the bucket
+func resourceObsBucketDirectoryFilesDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("prefix") {
+		return d.SetNewComputed("files")
+	}
+	source := d.Get("source").(string)
+	local, err := scanDirectory(source, d.Get("prefix").(string))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// the directory can be created during apply, missing source is reported then
+			log.Printf("[WARN] source directory %s doesn't exist: %s", source, err)
+			return d.SetNewComputed("files")
+		}
+		return fmt.Errorf("error scanning directory %s: %s", source, err)
+	}
+	files := make(map[string]interface{}, len(local))
+	for key, file := range local {
+		files[key] = file.MD5
+	}
+	if !reflect.DeepEqual(files, d.Get("files").(map[string]interface{})) {
+		return d.SetNew("files", files)
+	}
+	return nil
+}
+
+func resourceObsBucketDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := syncBucketDirectory(d, meta, false); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket").(string), d.Get("prefix").(string)))
+
+	return resourceObsBucketDirectoryRead(d, meta)
+}
+
+func resourceObsBucketDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	force := d.HasChanges("acl", "cache_control", "content_types")
+	if err := syncBucketDirectory(d, meta, force); err != nil {
+		return err
+	}
+
+	return resourceObsBucketDirectoryRead(d, meta)
+}
+
+// syncBucketDirectory uploads new and changed files and deletes previously uploaded objects
+// missing in the source, all files are uploaded when `force` is set
+func syncBucketDirectory(d *schema.ResourceData, meta interface{}, force bool) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	source := d.Get("source").(string)
+	local, err := scanDirectory(source, d.Get("prefix").(string))
+	if err != nil {
+		return fmt.Errorf("error scanning directory %s: %s", source, err)
+	}
+	remote, err := listDirectoryObjects(client, bucket, directoryPrefix(d.Get("prefix").(string)))
+	if err != nil {
+		return GetObsError("error listing objects of OBS bucket", bucket, err)
+	}
+
+	contentTypes := make(map[string]string)
+	for ext, contentType := range d.Get("content_types").(map[string]interface{}) {
+		contentTypes[normalizeExtension(ext)] = contentType.(string)
+	}
+
+	cacheControl := d.Get("cache_control").(string)
+	pool := newWorkerPool(d.Get("concurrency").(int))
+	uploaded := 0
+	for key, file := range local {
+		if !force && remote[key] == file.MD5 {
+			continue
+		}
+		uploaded++
+		key, file := key, file
+		pool.jobs <- func() error {
+			input := &obs.PutFileInput{}
+			input.Bucket = bucket
+			input.Key = key
+			input.SourceFile = file.Path
+			input.ContentType = fileContentType(key, contentTypes)
+			if v, ok := d.GetOk("acl"); ok {
+				input.ACL = obs.AclType(v.(string))
+			}
+			sum, _ := hex.DecodeString(file.MD5)
+			input.ContentMD5 = base64.StdEncoding.EncodeToString(sum)
+
+			log.Printf("[DEBUG] putting %s to OBS Bucket %s", key, bucket)
+			if _, err := client.PutFile(input); err != nil {
+				return GetObsError(fmt.Sprintf("error uploading %s to OBS bucket", file.Path), bucket, err)
+			}
+
+			// cache control can't be set on upload
+			if cacheControl != "" {
+				_, err := client.SetObjectMetadata(&obs.SetObjectMetadataInput{
+					Bucket:            bucket,
+					Key:               key,
+					MetadataDirective: obs.ReplaceMetadata,
+					CacheControl:      cacheControl,
+					ContentType:       input.ContentType,
+				})
+				if err != nil {
+					return GetObsError(fmt.Sprintf("error setting metadata of %s in OBS bucket", key), bucket, err)
+				}
+			}
+			return nil
+		}
+	}
+	if err := pool.wait(); err != nil {
+		return err
+	}
+
+	// only objects uploaded by the resource before are deleted
+	oldFiles, _ := d.GetChange("files")
+	var removed []string
+	for key := range oldFiles.(map[string]interface{}) {
+		if _, ok := local[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	if err := deleteObjects(client, bucket, removed); err != nil {
+		return GetObsError("error deleting objects of OBS bucket", bucket, err)
+	}
+
+	log.Printf("[DEBUG] synced %s to OBS bucket %s: %d uploaded, %d deleted", source, bucket, uploaded, len(removed))
+
+	files := make(map[string]interface{}, len(local))
+	for key, file := range local {
+		files[key] = file.MD5
+	}
+	return d.Set("files", files)
+}
+
+// listDirectoryObjects returns MD5 of the objects under the prefix mapped by keys,
+// folder placeholders are skipped
+func listDirectoryObjects(client *obs.ObsClient, bucket, prefix string) (map[string]string, error) {
+	contents, _, err := listAllObjects(client, bucket, prefix, "", 0)
+	if err != nil {
+		return nil, err
+	}
+	objects := make(map[string]string, len(contents))
+	for _, object := range contents {
+		if strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+		objects[object.Key] = strings.Trim(object.ETag, `"`)
+	}
+	return objects, nil
+}
+
+func normalizeExtension(ext string) string {
+	return "." + strings.TrimPrefix(strings.ToLower(ext), ".")
+}
+
+// fileContentType returns content type configured for the file extension
+// or the one known by the system
+func fileContentType(key string, contentTypes map[string]string) string {
+	ext := filepath.Ext(key)
+	if ext == "" {
+		return ""
+	}
+	if contentType, ok := contentTypes[normalizeExtension(ext)]; ok {
+		return contentType
+	}
+	return mime.TypeByExtension(ext)
+}
+
+func resourceObsBucketDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	remote, err := listDirectoryObjects(client, bucket, directoryPrefix(d.Get("prefix").(string)))
+	if err != nil {
+		if isObsNotFound(err) {
+			log.Printf("[WARN] OBS bucket %s not found, removing directory from state", bucket)
+			d.SetId("")
+			return nil
+		}
+		return GetObsError("error listing objects of OBS bucket", bucket, err)
+	}
+
+	// only objects uploaded by the resource are tracked, other objects under the prefix are left untouched
+	files := make(map[string]interface{})
+	for key := range d.Get("files").(map[string]interface{}) {
+		if etag, ok := remote[key]; ok {
+			files[key] = etag
+		}
+	}
+	return d.Set("files", files)
+}
+
+func resourceObsBucketDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	var keys []string
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if err := deleteObjects(client, bucket, keys); err != nil {
+		if isObsNotFound(err) {
+			return nil
+		}
+		return GetObsError("error deleting objects of OBS bucket", bucket, err)
+	}
+
+	return nil
+}
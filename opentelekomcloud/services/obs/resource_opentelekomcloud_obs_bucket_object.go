@@ -2,6 +2,9 @@ package obs
 
 import (
 	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
@@ -19,9 +22,11 @@ func ResourceObsBucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceObsBucketObjectPut,
 		Read:   resourceObsBucketObjectRead,
-		Update: resourceObsBucketObjectPut,
+		Update: resourceObsBucketObjectUpdate,
 		Delete: resourceObsBucketObjectDelete,
 
+		CustomizeDiff: resourceObsBucketObjectSourceMD5Diff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -43,6 +48,32 @@ func ResourceObsBucketObject() *schema.Resource {
 				Optional:     true,
 				AtLeastOneOf: []string{"source"},
 			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_md5": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(5),
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      16,
+				ValidateFunc: validation.IntBetween(5, 5120),
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 			"storage_class": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -70,10 +101,20 @@ func ResourceObsBucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateObjectMetadataKeys,
+			},
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with server-side-encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with server-side-encryption and multi-part upload.
+				// The Etag then won't match raw-file MD5, `source_hash` should be used instead.
 				Optional: true,
 				Computed: true,
 			},
@@ -90,7 +131,7 @@ func ResourceObsBucketObject() *schema.Resource {
 }
 
 func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
-	var resp *obs.PutObjectOutput
+	var versionID string
 	var err error
 
 	config := meta.(*cfg.Config)
@@ -101,7 +142,7 @@ func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error
 
 	if source, ok := d.GetOk("source"); ok {
 		// check source file whether exist
-		_, err := os.Stat(source.(string))
+		info, err := os.Stat(source.(string))
 		if err != nil {
 			if os.IsNotExist(err) {
 				return fmt.Errorf("source file %s does not exist", source)
@@ -110,12 +151,19 @@ func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error
 		}
 
 		// put source file
-		resp, err = putFileToObject(client, d)
+		if info.Size() > int64(d.Get("multipart_threshold").(int))*mb {
+			versionID, err = putFileToObjectMultipart(client, d)
+		} else {
+			versionID, err = putFileToObject(client, d)
+		}
+		if err != nil {
+			return GetObsError("error putting object to OBS bucket", d.Get("bucket").(string), err)
+		}
 	}
 
 	if _, ok := d.GetOk("content"); ok {
 		// put content
-		resp, err = putContentToObject(client, d)
+		versionID, err = putContentToObject(client, d)
 	}
 
 	bucket := d.Get("bucket").(string)
@@ -124,9 +172,9 @@ func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error
 		return GetObsError("error putting object to OBS bucket", bucket, err)
 	}
 
-	log.Printf("[DEBUG] Response of putting %s to OBS Bucket %s: %#v", key, bucket, resp)
-	if resp.VersionId != "null" {
-		err = d.Set("version_id", resp.VersionId)
+	log.Printf("[DEBUG] Version of %s put to OBS Bucket %s: %s", key, bucket, versionID)
+	if versionID != "null" {
+		err = d.Set("version_id", versionID)
 	} else {
 		err = d.Set("version_id", "")
 	}
@@ -136,58 +184,221 @@ func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(key)
 
+	// cache control can't be set on upload
+	if _, ok := d.GetOk("cache_control"); ok {
+		if err := setObjectMetadata(client, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceObsBucketObjectRead(d, meta)
 }
 
-func basicInput(d *schema.ResourceData) obs.PutObjectBasicInput {
-	common := obs.PutObjectBasicInput{
-		ObjectOperationInput: obs.ObjectOperationInput{
-			Bucket: d.Get("bucket").(string),
+func resourceObsBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("source", "source_hash", "source_md5", "content", "etag", "encryption", "kms_key_id") {
+		return resourceObsBucketObjectPut(d, meta)
+	}
+
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	if d.HasChange("acl") {
+		acl := d.Get("acl").(string)
+		if acl == "" {
+			acl = string(obs.AclPrivate)
+		}
+		input := &obs.SetObjectAclInput{
+			Bucket: bucket,
 			Key:    d.Get("key").(string),
-		},
-		ContentType: d.Get("content_type").(string),
+			ACL:    obs.AclType(acl),
+		}
+		if _, err := client.SetObjectAcl(input); err != nil {
+			return GetObsError("error setting ACL of object in OBS bucket", bucket, err)
+		}
 	}
-	if v, ok := d.GetOk("acl"); ok {
-		common.ACL = obs.AclType(v.(string))
+
+	if d.HasChanges("storage_class", "content_type", "cache_control", "metadata") {
+		if err := setObjectMetadata(client, d); err != nil {
+			return err
+		}
 	}
-	if v, ok := d.GetOk("storage_class"); ok {
-		common.StorageClass = obs.StorageClassType(v.(string))
+
+	return resourceObsBucketObjectRead(d, meta)
+}
+
+// setObjectMetadata replaces object metadata, changing storage class of the object in place
+func setObjectMetadata(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	input := &obs.SetObjectMetadataInput{
+		Bucket:            bucket,
+		Key:               d.Get("key").(string),
+		MetadataDirective: obs.ReplaceMetadata,
+		CacheControl:      d.Get("cache_control").(string),
+		ContentType:       d.Get("content_type").(string),
+		StorageClass:      obs.StorageClassType(d.Get("storage_class").(string)),
+		Metadata:          expandObjectMetadata(d),
+	}
+	log.Printf("[DEBUG] setting metadata of %s in OBS Bucket %s: %#v", input.Key, bucket, input)
+
+	if _, err := obsClient.SetObjectMetadata(input); err != nil {
+		return GetObsError("error setting metadata of object in OBS bucket", bucket, err)
+	}
+	return nil
+}
+
+func expandObjectMetadata(d *schema.ResourceData) map[string]string {
+	metadata := make(map[string]string)
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		metadata[k] = v.(string)
+	}
+	return metadata
+}
+
+// resourceObsBucketObjectSourceMD5Diff plans re-upload when MD5 of the source file
+// differs from the uploaded one
+func resourceObsBucketObjectSourceMD5Diff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source") {
+		return d.SetNewComputed("source_md5")
+	}
+	source := d.Get("source").(string)
+	if source == "" {
+		return nil
+	}
+	hash, err := fileMD5(source)
+	if err != nil {
+		// missing source is reported on apply
+		log.Printf("[WARN] error calculating MD5 of %s: %s", source, err)
+		return nil
+	}
+	if hash != d.Get("source_md5").(string) {
+		return d.SetNew("source_md5", hash)
+	}
+	return nil
+}
+
+// validateObjectMetadataKeys checks that metadata keys are lowercase, as OBS returns them lowercased
+func validateObjectMetadataKeys(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf("%q key %q must be lowercase", k, key))
+		}
+	}
+	return
+}
+
+const mb int64 = 1024 * 1024
+
+func basicInput(d *schema.ResourceData) obs.PutObjectBasicInput {
+	common := obs.PutObjectBasicInput{
+		ObjectOperationInput: objectOperationInput(d),
+		ContentType:          d.Get("content_type").(string),
 	}
 	if v, ok := d.GetOk("content_type"); ok {
 		common.ContentType = v.(string)
 	}
+	return common
+}
+
+func objectOperationInput(d *schema.ResourceData) obs.ObjectOperationInput {
+	input := obs.ObjectOperationInput{
+		Bucket:   d.Get("bucket").(string),
+		Key:      d.Get("key").(string),
+		Metadata: expandObjectMetadata(d),
+	}
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = obs.AclType(v.(string))
+	}
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = obs.StorageClassType(v.(string))
+	}
 	var sseKmsHeader = obs.SseKmsHeader{}
 	if d.Get("encryption").(bool) {
 		sseKmsHeader.Encryption = obs.DEFAULT_SSE_KMS_ENCRYPTION
 		sseKmsHeader.Key = d.Get("kms_key_id").(string)
-		common.SseHeader = sseKmsHeader
+		input.SseHeader = sseKmsHeader
 	}
-	return common
+	return input
 }
 
-func putContentToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.PutObjectOutput, error) {
-	content := d.Get("content").(string)
+func putContentToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (string, error) {
+	content := []byte(d.Get("content").(string))
 
 	putInput := &obs.PutObjectInput{
 		PutObjectBasicInput: basicInput(d),
 	}
+	sum := md5.Sum(content)
+	putInput.ContentMD5 = base64.StdEncoding.EncodeToString(sum[:])
 
 	log.Printf("[DEBUG] putting %s to OBS Bucket %s, opts: %#v", putInput.Key, putInput.Bucket, putInput)
 	// do not log content
-	body := bytes.NewReader([]byte(content))
+	body := bytes.NewReader(content)
 	putInput.Body = body
 
-	return obsClient.PutObject(putInput)
+	output, err := obsClient.PutObject(putInput)
+	if err != nil {
+		return "", err
+	}
+	return output.VersionId, nil
 }
 
-func putFileToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.PutObjectOutput, error) {
+func putFileToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (string, error) {
+	source := d.Get("source").(string)
+	hash, err := fileMD5(source)
+	if err != nil {
+		return "", err
+	}
+	sum, _ := hex.DecodeString(hash)
+
 	putInput := &obs.PutFileInput{
 		PutObjectBasicInput: basicInput(d),
 	}
-	putInput.SourceFile = d.Get("source").(string)
+	putInput.SourceFile = source
+	putInput.ContentMD5 = base64.StdEncoding.EncodeToString(sum)
 
 	log.Printf("[DEBUG] putting %s to OBS Bucket %s, opts: %#v", putInput.Key, putInput.Bucket, putInput)
-	return obsClient.PutFile(putInput)
+	output, err := obsClient.PutFile(putInput)
+	if err != nil {
+		return "", err
+	}
+	if err := d.Set("source_md5", hash); err != nil {
+		return "", err
+	}
+	return output.VersionId, nil
+}
+
+func putFileToObjectMultipart(obsClient *obs.ObsClient, d *schema.ResourceData) (string, error) {
+	source := d.Get("source").(string)
+	hash, err := fileMD5(source)
+	if err != nil {
+		return "", err
+	}
+
+	opts := multipartUploadOpts{
+		SourceFile:  source,
+		PartSize:    int64(d.Get("part_size").(int)) * mb,
+		Concurrency: d.Get("concurrency").(int),
+	}
+	opts.ObjectOperationInput = objectOperationInput(d)
+	opts.ContentType = d.Get("content_type").(string)
+	opts.Restart = !d.IsNewResource() &&
+		d.HasChanges("acl", "storage_class", "content_type", "metadata", "encryption", "kms_key_id")
+
+	log.Printf("[DEBUG] uploading %s to OBS Bucket %s in parts, opts: %#v", opts.Key, opts.Bucket, opts)
+	// keep the previous state on failure, so changed options still restart the upload on the next apply
+	d.Partial(true)
+	versionID, err := uploadFileMultipart(obsClient, opts)
+	if err != nil {
+		return "", err
+	}
+	d.Partial(false)
+	if err := d.Set("source_md5", hash); err != nil {
+		return "", err
+	}
+	return versionID, nil
 }
 
 func resourceObsBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
@@ -223,6 +434,18 @@ func resourceObsBucketObjectRead(d *schema.ResourceData, meta interface{}) error
 	}
 	log.Printf("[DEBUG] Reading OBS Bucket Object %s: %#v", key, object)
 
+	metadata, err := client.GetObjectMetadata(&obs.GetObjectMetadataInput{
+		Bucket: bucket,
+		Key:    key,
+	})
+	if err != nil {
+		return GetObsError("error getting metadata of object in OBS bucket", bucket, err)
+	}
+	var cacheControl string
+	if v, ok := metadata.ResponseHeaders["cache-control"]; ok {
+		cacheControl = v[0]
+	}
+
 	if class := string(object.StorageClass); class == "" {
 		err = d.Set("storage_class", "STANDARD")
 	} else {
@@ -231,6 +454,8 @@ func resourceObsBucketObjectRead(d *schema.ResourceData, meta interface{}) error
 	mErr := multierror.Append(err,
 		d.Set("size", object.Size),
 		d.Set("etag", strings.Trim(object.ETag, `"`)),
+		d.Set("cache_control", cacheControl),
+		d.Set("metadata", metadata.Metadata),
 	)
 
 	if err := mErr.ErrorOrNil(); err != nil {
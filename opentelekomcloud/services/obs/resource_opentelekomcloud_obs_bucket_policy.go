@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
@@ -23,6 +24,9 @@ func ResourceObsBucketPolicy() *schema.Resource {
 		Read:   resourceObsBucketPolicyRead,
 		Update: resourceObsBucketPolicyPut,
 		Delete: resourceObsBucketPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -91,7 +95,11 @@ func resourceObsBucketPolicyRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error getting bucket policy")
 	}
 
-	if err := d.Set("policy", pol.Policy); err != nil {
+	mErr := multierror.Append(
+		d.Set("bucket", d.Id()),
+		d.Set("policy", pol.Policy),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
 		return err
 	}
 
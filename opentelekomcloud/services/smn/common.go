@@ -0,0 +1,109 @@
+package smn
+
+import (
+	"fmt"
+	"net/url"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/subscriptions"
+)
+
+// getTopicAttribute returns the topic attribute, empty value means the attribute is not set
+func getTopicAttribute(client *golangsdk.ServiceClient, topicUrn, name string) (string, error) {
+	var result struct {
+		Attributes map[string]string `json:"attributes"`
+	}
+	_, err := client.Get(client.ServiceURL("topics", topicUrn, "attributes")+"?name="+url.QueryEscape(name), &result, nil)
+	if err != nil {
+		return "", err
+	}
+	return result.Attributes[name], nil
+}
+
+func setTopicAttribute(client *golangsdk.ServiceClient, topicUrn, name, value string) error {
+	body := map[string]interface{}{
+		"value": value,
+	}
+	_, err := client.Put(client.ServiceURL("topics", topicUrn, "attributes", name), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteTopicAttribute(client *golangsdk.ServiceClient, topicUrn, name string) error {
+	_, err := client.Delete(client.ServiceURL("topics", topicUrn, "attributes", name), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+type messageTemplate struct {
+	ID         string   `json:"message_template_id"`
+	Name       string   `json:"message_template_name"`
+	Protocol   string   `json:"protocol"`
+	Content    string   `json:"content"`
+	TagNames   []string `json:"tag_names"`
+	CreateTime string   `json:"create_time"`
+	UpdateTime string   `json:"update_time"`
+}
+
+func createMessageTemplate(client *golangsdk.ServiceClient, name, protocol, content string) (string, error) {
+	body := map[string]interface{}{
+		"message_template_name": name,
+		"protocol":              protocol,
+		"content":               content,
+	}
+	var result struct {
+		ID string `json:"message_template_id"`
+	}
+	_, err := client.Post(client.ServiceURL("message_template"), body, &result, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result.ID, err
+}
+
+func getMessageTemplate(client *golangsdk.ServiceClient, id string) (*messageTemplate, error) {
+	template := new(messageTemplate)
+	_, err := client.Get(client.ServiceURL("message_template", id), template, nil)
+	return template, err
+}
+
+func updateMessageTemplate(client *golangsdk.ServiceClient, id, content string) error {
+	body := map[string]interface{}{
+		"content": content,
+	}
+	_, err := client.Put(client.ServiceURL("message_template", id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteMessageTemplate(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("message_template", id), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// getSubscription looks for the subscription of the topic page by page
+func getSubscription(client *golangsdk.ServiceClient, topicUrn, subscriptionUrn string) (*subscriptions.SubscriptionGet, error) {
+	const limit = 100
+	for offset := 0; ; offset += limit {
+		var page struct {
+			Subscriptions []subscriptions.SubscriptionGet `json:"subscriptions"`
+		}
+		pageURL := client.ServiceURL("topics", topicUrn, "subscriptions") + fmt.Sprintf("?offset=%d&limit=%d", offset, limit)
+		if _, err := client.Get(pageURL, &page, nil); err != nil {
+			return nil, err
+		}
+		for _, subscription := range page.Subscriptions {
+			if subscription.SubscriptionUrn == subscriptionUrn {
+				return &subscription, nil
+			}
+		}
+		if len(page.Subscriptions) < limit {
+			break
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
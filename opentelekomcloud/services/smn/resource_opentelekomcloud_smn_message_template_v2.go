@@ -0,0 +1,136 @@
+package smn
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceMessageTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMessageTemplateCreate,
+		Read:   resourceMessageTemplateRead,
+		Update: resourceMessageTemplateUpdate,
+		Delete: resourceMessageTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"default", "email", "sms", "http", "https",
+				}, false),
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tag_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMessageTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	id, err := createMessageTemplate(client, d.Get("name").(string), d.Get("protocol").(string), d.Get("content").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating message template: %s", err)
+	}
+	log.Printf("[DEBUG] Created message template %s", id)
+	d.SetId(id)
+
+	return resourceMessageTemplateRead(d, meta)
+}
+
+func resourceMessageTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	template, err := getMessageTemplate(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "message template")
+	}
+	log.Printf("[DEBUG] Retrieved message template %s: %#v", d.Id(), template)
+
+	d.Set("name", template.Name)
+	d.Set("protocol", template.Protocol)
+	d.Set("content", template.Content)
+	d.Set("tag_names", template.TagNames)
+	d.Set("create_time", template.CreateTime)
+	d.Set("update_time", template.UpdateTime)
+
+	return nil
+}
+
+func resourceMessageTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	if d.HasChange("content") {
+		if err := updateMessageTemplate(client, d.Id(), d.Get("content").(string)); err != nil {
+			return fmt.Errorf("Error updating message template %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMessageTemplateRead(d, meta)
+}
+
+func resourceMessageTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting message template %s", d.Id())
+	if err := deleteMessageTemplate(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "message template")
+	}
+
+	d.SetId("")
+	return nil
+}
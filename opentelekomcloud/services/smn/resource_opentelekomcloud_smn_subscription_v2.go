@@ -3,8 +3,12 @@ package smn
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/subscriptions"
 
@@ -16,8 +20,14 @@ func ResourceSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSubscriptionCreate,
 		Read:   resourceSubscriptionRead,
+		Update: resourceSubscriptionUpdate,
 		Delete: resourceSubscriptionDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"topic_urn": {
 				Type:     schema.TypeString,
@@ -60,10 +70,47 @@ func ResourceSubscription() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"wait_for_confirmation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
+const (
+	subscriptionUnconfirmed         = 0
+	subscriptionConfirmed           = 1
+	subscriptionConfirmationSkipped = 2
+)
+
+// waitForSubscriptionConfirmation waits until the endpoint owner confirms the subscription
+func waitForSubscriptionConfirmation(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) error {
+	topicUrn := d.Get("topic_urn").(string)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{strconv.Itoa(subscriptionUnconfirmed)},
+		Target: []string{
+			strconv.Itoa(subscriptionConfirmed),
+			strconv.Itoa(subscriptionConfirmationSkipped),
+		},
+		Refresh: func() (interface{}, string, error) {
+			subscription, err := getSubscription(client, topicUrn, d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			return subscription, strconv.Itoa(subscription.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for subscription %s to be confirmed: %s", d.Id(), err)
+	}
+	return nil
+}
+
 func resourceSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.SmnV2Client(config.GetProjectName(d))
@@ -86,12 +133,33 @@ func resourceSubscriptionCreate(d *schema.ResourceData, meta interface{}) error
 	if subscription.SubscriptionUrn != "" {
 		d.SetId(subscription.SubscriptionUrn)
 		d.Set("subscription_urn", subscription.SubscriptionUrn)
+		if d.Get("wait_for_confirmation").(bool) {
+			if err := waitForSubscriptionConfirmation(d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+		}
 		return resourceSubscriptionRead(d, meta)
 	}
 
 	return fmt.Errorf("Unexpected conversion error in resourceSubscriptionCreate.")
 }
 
+func resourceSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	if d.Get("wait_for_confirmation").(bool) {
+		if err := waitForSubscriptionConfirmation(d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceSubscriptionRead(d, meta)
+}
+
 func resourceSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.SmnV2Client(config.GetProjectName(d))
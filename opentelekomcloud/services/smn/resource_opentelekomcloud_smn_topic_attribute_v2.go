@@ -0,0 +1,155 @@
+package smn
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceTopicAttribute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTopicAttributeCreate,
+		Read:   resourceTopicAttributeRead,
+		Update: resourceTopicAttributeUpdate,
+		Delete: resourceTopicAttributeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTopicAttributeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"topic_urn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attribute_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"access_policy", "introduction",
+				}, false),
+			},
+			"value": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// suppressEquivalentJsonDiffs ignores formatting changes of `access_policy` documents
+func suppressEquivalentJsonDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	oldJson, err := common.NormalizeJsonString(old)
+	if err != nil {
+		return false
+	}
+	newJson, err := common.NormalizeJsonString(new)
+	if err != nil {
+		return false
+	}
+	return oldJson == newJson
+}
+
+func resourceTopicAttributeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	topicUrn := d.Get("topic_urn").(string)
+	name := d.Get("attribute_name").(string)
+	if err := setTopicAttribute(client, topicUrn, name, d.Get("value").(string)); err != nil {
+		return fmt.Errorf("Error setting attribute %s of topic %s: %s", name, topicUrn, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", topicUrn, name))
+
+	return resourceTopicAttributeRead(d, meta)
+}
+
+func resourceTopicAttributeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	topicUrn := d.Get("topic_urn").(string)
+	name := d.Get("attribute_name").(string)
+	value, err := getTopicAttribute(client, topicUrn, name)
+	if err != nil {
+		return common.CheckDeleted(d, err, "topic attribute")
+	}
+	if value == "" {
+		log.Printf("[WARN] Attribute %s of topic %s is not set, removing from state", name, topicUrn)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("value", value)
+
+	return nil
+}
+
+func resourceTopicAttributeUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	topicUrn := d.Get("topic_urn").(string)
+	name := d.Get("attribute_name").(string)
+	if err := setTopicAttribute(client, topicUrn, name, d.Get("value").(string)); err != nil {
+		return fmt.Errorf("Error updating attribute %s of topic %s: %s", name, topicUrn, err)
+	}
+
+	return resourceTopicAttributeRead(d, meta)
+}
+
+func resourceTopicAttributeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.SmnV2Client(config.GetProjectName(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	topicUrn := d.Get("topic_urn").(string)
+	name := d.Get("attribute_name").(string)
+	log.Printf("[DEBUG] Deleting attribute %s of topic %s", name, topicUrn)
+	if err := deleteTopicAttribute(client, topicUrn, name); err != nil {
+		return common.CheckDeleted(d, err, "topic attribute")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceTopicAttributeImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	idx := strings.LastIndex(d.Id(), "/")
+	if idx < 0 {
+		return nil, fmt.Errorf("invalid format specified for topic attribute, must be <topic_urn>/<attribute_name>")
+	}
+	if err := d.Set("topic_urn", d.Id()[:idx]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("attribute_name", d.Id()[idx+1:]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,307 @@
+package waf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+// resourceWafRuleImport imports WAF rules using `<policy_id>/<rule_id>` ID
+func resourceWafRuleImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid format specified for WAF rule. Format must be <policy id>/<rule id>")
+	}
+
+	d.SetId(parts[1])
+	if err := d.Set("policy_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateRule updates WAF rule of the given type, e.g. `cc` or `custom`,
+// those are missing in the SDK
+func updateRule(client *golangsdk.ServiceClient, ruleType, policyID, ruleID string, body interface{}) error {
+	reqOpts := openstack.StdRequestOpts()
+	reqOpts.OkCodes = []int{200}
+	_, err := client.Put(client.ServiceURL("policy", policyID, ruleType, ruleID), body, nil, reqOpts)
+	return err
+}
+
+type geoRuleOpts struct {
+	Name  string `json:"name,omitempty"`
+	GeoIP string `json:"geoip"`
+	White int    `json:"white"`
+}
+
+type geoRule struct {
+	ID        string `json:"id"`
+	PolicyID  string `json:"policyid"`
+	Name      string `json:"name"`
+	GeoIP     string `json:"geoip"`
+	White     int    `json:"white"`
+	Status    int    `json:"status"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func createGeoRule(client *golangsdk.ServiceClient, policyID string, opts geoRuleOpts) (*geoRule, error) {
+	result := new(geoRule)
+	reqOpts := openstack.StdRequestOpts()
+	reqOpts.OkCodes = []int{200}
+	_, err := client.Post(client.ServiceURL("policy", policyID, "geoip"), opts, result, reqOpts)
+	return result, err
+}
+
+func getGeoRule(client *golangsdk.ServiceClient, policyID, ruleID string) (*geoRule, error) {
+	result := new(geoRule)
+	_, err := client.Get(client.ServiceURL("policy", policyID, "geoip", ruleID), result, openstack.StdRequestOpts())
+	return result, err
+}
+
+func deleteGeoRule(client *golangsdk.ServiceClient, policyID, ruleID string) error {
+	reqOpts := openstack.StdRequestOpts()
+	reqOpts.OkCodes = []int{200, 204}
+	_, err := client.Delete(client.ServiceURL("policy", policyID, "geoip", ruleID), reqOpts)
+	return err
+}
+
+type referenceTableOpts struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Values      []string `json:"values"`
+	Description string   `json:"description"`
+}
+
+type referenceTable struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Values      []string `json:"values"`
+	Description string   `json:"description"`
+	Timestamp   int64    `json:"timestamp"`
+}
+
+func createReferenceTable(client *golangsdk.ServiceClient, opts referenceTableOpts) (*referenceTable, error) {
+	result := new(referenceTable)
+	reqOpts := openstack.StdRequestOpts()
+	reqOpts.OkCodes = []int{200}
+	_, err := client.Post(client.ServiceURL("valuelist"), opts, result, reqOpts)
+	return result, err
+}
+
+func getReferenceTable(client *golangsdk.ServiceClient, id string) (*referenceTable, error) {
+	result := new(referenceTable)
+	_, err := client.Get(client.ServiceURL("valuelist", id), result, openstack.StdRequestOpts())
+	return result, err
+}
+
+func updateReferenceTable(client *golangsdk.ServiceClient, id string, opts referenceTableOpts) error {
+	reqOpts := openstack.StdRequestOpts()
+	reqOpts.OkCodes = []int{200}
+	_, err := client.Put(client.ServiceURL("valuelist", id), opts, nil, reqOpts)
+	return err
+}
+
+func deleteReferenceTable(client *golangsdk.ServiceClient, id string) error {
+	reqOpts := openstack.StdRequestOpts()
+	reqOpts.OkCodes = []int{200, 204}
+	_, err := client.Delete(client.ServiceURL("valuelist", id), reqOpts)
+	return err
+}
+
+// Dedicated WAF engines and domains are managed with `premium-waf` API, policies and rules are shared with cloud mode
+
+type dedicatedInstanceOpts struct {
+	Region         string   `json:"region"`
+	ChargeMode     int      `json:"chargemode"`
+	AvailableZone  string   `json:"available_zone"`
+	Arch           string   `json:"arch"`
+	NamePrefix     string   `json:"instancename"`
+	Specification  string   `json:"specification"`
+	CpuFlavor      string   `json:"cpu_flavor"`
+	VpcID          string   `json:"vpc_id"`
+	SubnetID       string   `json:"subnet_id"`
+	SecurityGroups []string `json:"security_group"`
+	Count          int      `json:"count"`
+}
+
+type dedicatedInstance struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"instancename"`
+	Region           string   `json:"region"`
+	Zone             string   `json:"zone"`
+	Arch             string   `json:"arch"`
+	CpuFlavor        string   `json:"cpu_flavor"`
+	VpcID            string   `json:"vpc_id"`
+	SubnetID         string   `json:"subnet_id"`
+	ServiceIP        string   `json:"service_ip"`
+	SecurityGroupIDs []string `json:"security_group_ids"`
+	Status           int      `json:"status"`
+	RunStatus        int      `json:"run_status"`
+	AccessStatus     int      `json:"access_status"`
+	Upgradable       int      `json:"upgradable"`
+	Specification    string   `json:"resourceSpecCode"`
+	ServerID         string   `json:"serverId"`
+}
+
+func createDedicatedInstance(client *golangsdk.ServiceClient, opts dedicatedInstanceOpts) (string, error) {
+	var result struct {
+		Instances []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"instances"`
+	}
+	_, err := client.Post(client.ServiceURL("instance"), opts, &result, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(result.Instances) == 0 {
+		return "", fmt.Errorf("no instances returned in the response")
+	}
+	return result.Instances[0].ID, nil
+}
+
+func getDedicatedInstance(client *golangsdk.ServiceClient, id string) (*dedicatedInstance, error) {
+	result := new(dedicatedInstance)
+	_, err := client.Get(client.ServiceURL("instance", id), result, nil)
+	return result, err
+}
+
+func renameDedicatedInstance(client *golangsdk.ServiceClient, id, name string) error {
+	body := map[string]string{"instancename": name}
+	_, err := client.Put(client.ServiceURL("instance", id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteDedicatedInstance(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("instance", id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+type dedicatedServer struct {
+	FrontProtocol string `json:"front_protocol"`
+	BackProtocol  string `json:"back_protocol"`
+	Address       string `json:"address"`
+	Port          int    `json:"port"`
+	Type          string `json:"type"`
+	VpcID         string `json:"vpc_id"`
+}
+
+type dedicatedDomainOpts struct {
+	Hostname        string            `json:"hostname,omitempty"`
+	Server          []dedicatedServer `json:"server,omitempty"`
+	CertificateID   string            `json:"certificateid,omitempty"`
+	CertificateName string            `json:"certificatename,omitempty"`
+	Proxy           *bool             `json:"proxy,omitempty"`
+	PolicyID        string            `json:"policyid,omitempty"`
+}
+
+type dedicatedDomain struct {
+	ID              string            `json:"id"`
+	Hostname        string            `json:"hostname"`
+	Protocol        string            `json:"protocol"`
+	Server          []dedicatedServer `json:"server"`
+	CertificateID   string            `json:"certificateid"`
+	CertificateName string            `json:"certificatename"`
+	Proxy           bool              `json:"proxy"`
+	PolicyID        string            `json:"policyid"`
+	ProtectStatus   int               `json:"protect_status"`
+	AccessStatus    int               `json:"access_status"`
+}
+
+func createDedicatedDomain(client *golangsdk.ServiceClient, opts dedicatedDomainOpts) (*dedicatedDomain, error) {
+	result := new(dedicatedDomain)
+	_, err := client.Post(client.ServiceURL("host"), opts, result, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return result, err
+}
+
+func getDedicatedDomain(client *golangsdk.ServiceClient, id string) (*dedicatedDomain, error) {
+	result := new(dedicatedDomain)
+	_, err := client.Get(client.ServiceURL("host", id), result, nil)
+	return result, err
+}
+
+func updateDedicatedDomain(client *golangsdk.ServiceClient, id string, opts dedicatedDomainOpts) error {
+	_, err := client.Put(client.ServiceURL("host", id), opts, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func updateDedicatedDomainProtectStatus(client *golangsdk.ServiceClient, id string, status int) error {
+	body := map[string]int{"protect_status": status}
+	_, err := client.Put(client.ServiceURL("host", id, "protect-status"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteDedicatedDomain(client *golangsdk.ServiceClient, id string, keepPolicy bool) error {
+	url := fmt.Sprintf("%s?keepPolicy=%t", client.ServiceURL("host", id), keepPolicy)
+	_, err := client.Delete(url, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
+
+// policyRule contains fields of all rule types managed with rule sets
+type policyRule struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	Addr       string             `json:"addr"`
+	White      int                `json:"white"`
+	GeoIP      string             `json:"geoip"`
+	Conditions []preciseCondition `json:"conditions"`
+	Action     struct {
+		Category string `json:"category"`
+	} `json:"action"`
+	Priority int `json:"priority"`
+}
+
+func createPolicyRule(client *golangsdk.ServiceClient, ruleType, policyID string, body interface{}) (*policyRule, error) {
+	result := new(policyRule)
+	reqOpts := openstack.StdRequestOpts()
+	reqOpts.OkCodes = []int{200}
+	_, err := client.Post(client.ServiceURL("policy", policyID, ruleType), body, result, reqOpts)
+	return result, err
+}
+
+func deletePolicyRule(client *golangsdk.ServiceClient, ruleType, policyID, ruleID string) error {
+	reqOpts := openstack.StdRequestOpts()
+	reqOpts.OkCodes = []int{200, 204}
+	_, err := client.Delete(client.ServiceURL("policy", policyID, ruleType, ruleID), reqOpts)
+	return err
+}
+
+// listPolicyRules lists all rules of the given type, following `offset` pages
+func listPolicyRules(client *golangsdk.ServiceClient, ruleType, policyID string) ([]policyRule, error) {
+	const limit = 100
+	var rules []policyRule
+	for offset := 0; ; offset++ {
+		var result struct {
+			Total int          `json:"total"`
+			Items []policyRule `json:"items"`
+		}
+		url := fmt.Sprintf("%s?offset=%d&limit=%d", client.ServiceURL("policy", policyID, ruleType), offset, limit)
+		if _, err := client.Get(url, &result, openstack.StdRequestOpts()); err != nil {
+			return nil, err
+		}
+		rules = append(rules, result.Items...)
+		if len(result.Items) < limit || len(rules) >= result.Total {
+			return rules, nil
+		}
+	}
+}
@@ -16,9 +16,10 @@ func ResourceWafCcAttackProtectionRuleV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWafCcAttackProtectionRuleV1Create,
 		Read:   resourceWafCcAttackProtectionRuleV1Read,
+		Update: resourceWafCcAttackProtectionRuleV1Update,
 		Delete: resourceWafCcAttackProtectionRuleV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceWafRuleImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -35,42 +36,34 @@ func ResourceWafCcAttackProtectionRuleV1() *schema.Resource {
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"limit_num": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 			"limit_period": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 			"lock_time": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 			},
 			"tag_type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"tag_index": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"tag_category": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"tag_contents": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -78,17 +71,14 @@ func ResourceWafCcAttackProtectionRuleV1() *schema.Resource {
 			"action_category": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"block_content_type": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"block_content": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"default": {
 				Type:     schema.TypeBool,
@@ -132,6 +122,28 @@ func getCcAction(d *schema.ResourceData) ccattackprotection_rules.Action {
 	return action
 }
 
+func getCcOpts(d *schema.ResourceData) ccattackprotection_rules.CreateOpts {
+	limitNum := d.Get("limit_num").(int)
+	limitPeriod := d.Get("limit_period").(int)
+	lockTime := d.Get("lock_time").(int)
+	opts := ccattackprotection_rules.CreateOpts{
+		Url:         d.Get("url").(string),
+		LimitNum:    &limitNum,
+		LimitPeriod: &limitPeriod,
+		LockTime:    &lockTime,
+		TagType:     d.Get("tag_type").(string),
+		TagIndex:    d.Get("tag_index").(string),
+		Action:      getCcAction(d),
+	}
+
+	_, tagCategoryOk := d.GetOk("tag_category")
+	_, tagContentsOk := d.GetOk("tag_contents")
+	if tagCategoryOk && tagContentsOk {
+		opts.TagCondition = getTagCondition(d)
+	}
+	return opts
+}
+
 func resourceWafCcAttackProtectionRuleV1Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 
@@ -141,25 +153,7 @@ func resourceWafCcAttackProtectionRuleV1Create(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error creating OpenTelekomcomCloud WAF Client: %s", err)
 	}
 
-	limit_num := d.Get("limit_num").(int)
-	limit_period := d.Get("limit_period").(int)
-	lock_time := d.Get("lock_time").(int)
-	createOpts := ccattackprotection_rules.CreateOpts{
-		Url:         d.Get("url").(string),
-		LimitNum:    &limit_num,
-		LimitPeriod: &limit_period,
-		LockTime:    &lock_time,
-		TagType:     d.Get("tag_type").(string),
-		TagIndex:    d.Get("tag_index").(string),
-		Action:      getCcAction(d),
-	}
-
-	_, tag_category_ok := d.GetOk("tag_category")
-	_, tag_contents_ok := d.GetOk("tag_contents")
-	if tag_category_ok && tag_contents_ok {
-		createOpts.TagCondition = getTagCondition(d)
-	}
-
+	createOpts := getCcOpts(d)
 	policy_id := d.Get("policy_id").(string)
 	rule, err := ccattackprotection_rules.Create(wafClient, policy_id, createOpts).Extract()
 	if err != nil {
@@ -208,6 +202,27 @@ func resourceWafCcAttackProtectionRuleV1Read(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceWafCcAttackProtectionRuleV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	updateOpts, err := getCcOpts(d).ToCcAttackCreateMap()
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] updateOpts: %#v", updateOpts)
+
+	policy_id := d.Get("policy_id").(string)
+	if err := updateRule(wafClient, "cc", policy_id, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud WAF CC Attack Protection Rule: %s", err)
+	}
+
+	return resourceWafCcAttackProtectionRuleV1Read(d, meta)
+}
+
 func resourceWafCcAttackProtectionRuleV1Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	wafClient, err := config.WafV1Client(config.GetRegion(d))
@@ -19,7 +19,7 @@ func ResourceWafDataMaskingRuleV1() *schema.Resource {
 		Update: resourceWafDataMaskingRuleV1Update,
 		Delete: resourceWafDataMaskingRuleV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceWafRuleImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
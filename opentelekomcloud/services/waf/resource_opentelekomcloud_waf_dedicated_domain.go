@@ -0,0 +1,263 @@
+package waf
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceWafDedicatedDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWafDedicatedDomainCreate,
+		Read:   resourceWafDedicatedDomainRead,
+		Update: resourceWafDedicatedDomainUpdate,
+		Delete: resourceWafDedicatedDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"HTTP", "HTTPS",
+							}, false),
+						},
+						"server_protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"HTTP", "HTTPS",
+							}, false),
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "ipv4",
+							ValidateFunc: validation.StringInSlice([]string{
+								"ipv4", "ipv6",
+							}, false),
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"certificate_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"certificate_name"},
+			},
+			"certificate_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"certificate_id"},
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"proxy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"keep_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"protect_status": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"access_status": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getDedicatedServers(d *schema.ResourceData) []dedicatedServer {
+	rawServers := d.Get("server").([]interface{})
+	servers := make([]dedicatedServer, len(rawServers))
+	for i, v := range rawServers {
+		server := v.(map[string]interface{})
+		servers[i] = dedicatedServer{
+			FrontProtocol: server["client_protocol"].(string),
+			BackProtocol:  server["server_protocol"].(string),
+			Address:       server["address"].(string),
+			Port:          server["port"].(int),
+			Type:          server["type"].(string),
+			VpcID:         server["vpc_id"].(string),
+		}
+	}
+	return servers
+}
+
+func resourceWafDedicatedDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated client: %s", err)
+	}
+
+	proxy := d.Get("proxy").(bool)
+	createOpts := dedicatedDomainOpts{
+		Hostname:        d.Get("domain").(string),
+		Server:          getDedicatedServers(d),
+		CertificateID:   d.Get("certificate_id").(string),
+		CertificateName: d.Get("certificate_name").(string),
+		Proxy:           &proxy,
+		PolicyID:        d.Get("policy_id").(string),
+	}
+	log.Printf("[DEBUG] CreateOpts: %#v", createOpts)
+
+	domain, err := createDedicatedDomain(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated domain: %s", err)
+	}
+	d.SetId(domain.ID)
+
+	// domains are created with protection enabled
+	if status := d.Get("protect_status").(int); status != 1 {
+		if err := updateDedicatedDomainProtectStatus(client, d.Id(), status); err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud WAF dedicated domain protect status: %s", err)
+		}
+	}
+
+	return resourceWafDedicatedDomainRead(d, meta)
+}
+
+func resourceWafDedicatedDomainRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated client: %s", err)
+	}
+
+	domain, err := getDedicatedDomain(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error retrieving OpenTelekomCloud WAF dedicated domain")
+	}
+	log.Printf("[DEBUG] Retrieved WAF dedicated domain %s: %#v", d.Id(), domain)
+
+	servers := make([]map[string]interface{}, len(domain.Server))
+	for i, server := range domain.Server {
+		servers[i] = map[string]interface{}{
+			"client_protocol": server.FrontProtocol,
+			"server_protocol": server.BackProtocol,
+			"address":         server.Address,
+			"port":            server.Port,
+			"type":            server.Type,
+			"vpc_id":          server.VpcID,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("domain", domain.Hostname),
+		d.Set("server", servers),
+		d.Set("certificate_id", domain.CertificateID),
+		d.Set("certificate_name", domain.CertificateName),
+		d.Set("policy_id", domain.PolicyID),
+		d.Set("proxy", domain.Proxy),
+		d.Set("protect_status", domain.ProtectStatus),
+		d.Set("access_status", domain.AccessStatus),
+		d.Set("protocol", domain.Protocol),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting WAF dedicated domain fields: %s", err)
+	}
+	return nil
+}
+
+func resourceWafDedicatedDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated client: %s", err)
+	}
+
+	if d.HasChanges("server", "certificate_id", "certificate_name", "proxy") {
+		proxy := d.Get("proxy").(bool)
+		updateOpts := dedicatedDomainOpts{
+			Server:          getDedicatedServers(d),
+			CertificateID:   d.Get("certificate_id").(string),
+			CertificateName: d.Get("certificate_name").(string),
+			Proxy:           &proxy,
+		}
+		log.Printf("[DEBUG] UpdateOpts: %#v", updateOpts)
+
+		if err := updateDedicatedDomain(client, d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud WAF dedicated domain: %s", err)
+		}
+	}
+
+	if d.HasChange("protect_status") {
+		if err := updateDedicatedDomainProtectStatus(client, d.Id(), d.Get("protect_status").(int)); err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud WAF dedicated domain protect status: %s", err)
+		}
+	}
+
+	return resourceWafDedicatedDomainRead(d, meta)
+}
+
+func resourceWafDedicatedDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated client: %s", err)
+	}
+
+	if err := deleteDedicatedDomain(client, d.Id(), d.Get("keep_policy").(bool)); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud WAF dedicated domain")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,274 @@
+package waf
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// run_status values of dedicated WAF instance
+const (
+	instanceCreating       = 0
+	instanceRunning        = 1
+	instanceDeleting       = 2
+	instanceDeleted        = 3
+	instanceCreationFailed = 4
+)
+
+func ResourceWafDedicatedInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWafDedicatedInstanceCreate,
+		Read:   resourceWafDedicatedInstanceRead,
+		Update: resourceWafDedicatedInstanceUpdate,
+		Delete: resourceWafDedicatedInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"specification": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"waf.instance.professional", "waf.instance.enterprise",
+				}, false),
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"architecture": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "x86",
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"run_status": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"access_status": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"upgradable": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWafDedicatedInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := config.WafDedicatedV1Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated client: %s", err)
+	}
+
+	rawGroups := d.Get("security_group").(*schema.Set).List()
+	groups := make([]string, len(rawGroups))
+	for i, v := range rawGroups {
+		groups[i] = v.(string)
+	}
+
+	createOpts := dedicatedInstanceOpts{
+		Region:         region,
+		ChargeMode:     30,
+		AvailableZone:  d.Get("availability_zone").(string),
+		Arch:           d.Get("architecture").(string),
+		NamePrefix:     d.Get("name").(string),
+		Specification:  d.Get("specification").(string),
+		CpuFlavor:      d.Get("flavor").(string),
+		VpcID:          d.Get("vpc_id").(string),
+		SubnetID:       d.Get("subnet_id").(string),
+		SecurityGroups: groups,
+		Count:          1,
+	}
+	log.Printf("[DEBUG] CreateOpts: %#v", createOpts)
+
+	id, err := createDedicatedInstance(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated instance: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Waiting for WAF dedicated instance (%s) to become running", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{strconv.Itoa(instanceCreating)},
+		Target:     []string{strconv.Itoa(instanceRunning)},
+		Refresh:    wafDedicatedInstanceStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for WAF dedicated instance (%s) to become running: %s", d.Id(), err)
+	}
+
+	// name of the created instance is only prefixed with `instancename`
+	instance, err := getDedicatedInstance(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenTelekomCloud WAF dedicated instance: %s", err)
+	}
+	if instance.Name != createOpts.NamePrefix {
+		if err := renameDedicatedInstance(client, d.Id(), createOpts.NamePrefix); err != nil {
+			return fmt.Errorf("error renaming OpenTelekomCloud WAF dedicated instance: %s", err)
+		}
+	}
+
+	return resourceWafDedicatedInstanceRead(d, meta)
+}
+
+func resourceWafDedicatedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated client: %s", err)
+	}
+
+	instance, err := getDedicatedInstance(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error retrieving OpenTelekomCloud WAF dedicated instance")
+	}
+	log.Printf("[DEBUG] Retrieved WAF dedicated instance %s: %#v", d.Id(), instance)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", instance.Region),
+		d.Set("name", instance.Name),
+		d.Set("availability_zone", instance.Zone),
+		d.Set("specification", instance.Specification),
+		d.Set("flavor", instance.CpuFlavor),
+		d.Set("architecture", instance.Arch),
+		d.Set("vpc_id", instance.VpcID),
+		d.Set("subnet_id", instance.SubnetID),
+		d.Set("security_group", instance.SecurityGroupIDs),
+		d.Set("server_id", instance.ServerID),
+		d.Set("service_ip", instance.ServiceIP),
+		d.Set("run_status", instance.RunStatus),
+		d.Set("access_status", instance.AccessStatus),
+		d.Set("upgradable", instance.Upgradable),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting WAF dedicated instance fields: %s", err)
+	}
+	return nil
+}
+
+func resourceWafDedicatedInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		if err := renameDedicatedInstance(client, d.Id(), d.Get("name").(string)); err != nil {
+			return fmt.Errorf("error renaming OpenTelekomCloud WAF dedicated instance: %s", err)
+		}
+	}
+
+	return resourceWafDedicatedInstanceRead(d, meta)
+}
+
+func resourceWafDedicatedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.WafDedicatedV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud WAF dedicated client: %s", err)
+	}
+
+	if err := deleteDedicatedInstance(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud WAF dedicated instance")
+	}
+
+	log.Printf("[DEBUG] Waiting for WAF dedicated instance (%s) to delete", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{strconv.Itoa(instanceRunning), strconv.Itoa(instanceDeleting)},
+		Target:     []string{strconv.Itoa(instanceDeleted)},
+		Refresh:    wafDedicatedInstanceStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for WAF dedicated instance (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func wafDedicatedInstanceStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := getDedicatedInstance(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return instance, strconv.Itoa(instanceDeleted), nil
+			}
+			return nil, "", err
+		}
+		if instance.RunStatus == instanceCreationFailed {
+			return instance, "", fmt.Errorf("WAF dedicated instance creation failed")
+		}
+		return instance, strconv.Itoa(instance.RunStatus), nil
+	}
+}
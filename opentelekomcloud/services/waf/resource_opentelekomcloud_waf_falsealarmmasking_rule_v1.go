@@ -15,9 +15,10 @@ func ResourceWafFalseAlarmMaskingRuleV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWafFalseAlarmMaskingRuleV1Create,
 		Read:   resourceWafFalseAlarmMaskingRuleV1Read,
+		Update: resourceWafFalseAlarmMaskingRuleV1Update,
 		Delete: resourceWafFalseAlarmMaskingRuleV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceWafRuleImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -34,12 +35,10 @@ func ResourceWafFalseAlarmMaskingRuleV1() *schema.Resource {
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"rule": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 		},
 	}
@@ -97,6 +96,30 @@ func resourceWafFalseAlarmMaskingRuleV1Read(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+func resourceWafFalseAlarmMaskingRuleV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	updateOpts, err := falsealarmmasking_rules.CreateOpts{
+		Url:  d.Get("url").(string),
+		Rule: d.Get("rule").(string),
+	}.ToAlarmMaskingCreateMap()
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] updateOpts: %#v", updateOpts)
+
+	policy_id := d.Get("policy_id").(string)
+	if err := updateRule(wafClient, "ignore", policy_id, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud WAF False Alarm Masking Rule: %s", err)
+	}
+
+	return resourceWafFalseAlarmMaskingRuleV1Read(d, meta)
+}
+
 func resourceWafFalseAlarmMaskingRuleV1Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	wafClient, err := config.WafV1Client(config.GetRegion(d))
@@ -0,0 +1,153 @@
+package waf
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceWafGeolocationRuleV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWafGeolocationRuleV1Create,
+		Read:   resourceWafGeolocationRuleV1Read,
+		Update: resourceWafGeolocationRuleV1Update,
+		Delete: resourceWafGeolocationRuleV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceWafRuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"geoip": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"white": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntInSlice([]int{0, 1, 2}),
+			},
+			"status": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// getGeoRuleOpts builds rule options, locations are sent as `|`-separated list, e.g. `DE|FR`
+func getGeoRuleOpts(d *schema.ResourceData) geoRuleOpts {
+	rawLocations := d.Get("geoip").(*schema.Set).List()
+	locations := make([]string, len(rawLocations))
+	for i, v := range rawLocations {
+		locations[i] = v.(string)
+	}
+	sort.Strings(locations)
+
+	return geoRuleOpts{
+		Name:  d.Get("name").(string),
+		GeoIP: strings.Join(locations, "|"),
+		White: d.Get("white").(int),
+	}
+}
+
+func resourceWafGeolocationRuleV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	createOpts := getGeoRuleOpts(d)
+	log.Printf("[DEBUG] CreateOpts: %#v", createOpts)
+
+	rule, err := createGeoRule(wafClient, d.Get("policy_id").(string), createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Geolocation Rule: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waf geolocation rule created: %#v", rule)
+	d.SetId(rule.ID)
+
+	return resourceWafGeolocationRuleV1Read(d, meta)
+}
+
+func resourceWafGeolocationRuleV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF client: %s", err)
+	}
+
+	rule, err := getGeoRule(wafClient, d.Get("policy_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "Error retrieving OpenTelekomCloud WAF Geolocation Rule")
+	}
+
+	var locations []string
+	if rule.GeoIP != "" {
+		locations = strings.Split(rule.GeoIP, "|")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("policy_id", rule.PolicyID),
+		d.Set("name", rule.Name),
+		d.Set("geoip", locations),
+		d.Set("white", rule.White),
+		d.Set("status", rule.Status),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceWafGeolocationRuleV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	updateOpts := getGeoRuleOpts(d)
+	log.Printf("[DEBUG] updateOpts: %#v", updateOpts)
+
+	if err := updateRule(wafClient, "geoip", d.Get("policy_id").(string), d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud WAF Geolocation Rule: %s", err)
+	}
+
+	return resourceWafGeolocationRuleV1Read(d, meta)
+}
+
+func resourceWafGeolocationRuleV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF client: %s", err)
+	}
+
+	if err := deleteGeoRule(wafClient, d.Get("policy_id").(string), d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "Error deleting OpenTelekomCloud WAF Geolocation Rule")
+	}
+
+	d.SetId("")
+	return nil
+}
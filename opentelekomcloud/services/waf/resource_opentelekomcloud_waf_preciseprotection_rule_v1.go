@@ -17,11 +17,14 @@ func ResourceWafPreciseProtectionRuleV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWafPreciseProtectionRuleV1Create,
 		Read:   resourceWafPreciseProtectionRuleV1Read,
+		Update: resourceWafPreciseProtectionRuleV1Update,
 		Delete: resourceWafPreciseProtectionRuleV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceWafRuleImport,
 		},
 
+		CustomizeDiff: validatePreciseConditions,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -36,73 +39,106 @@ func ResourceWafPreciseProtectionRuleV1() *schema.Resource {
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"time": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 			"start": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"end": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"conditions": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"category": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"index": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						"logic": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"contents": {
 							Type:     schema.TypeList,
-							Required: true,
-							ForceNew: true,
+							Optional: true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
 						},
+						"reference_table_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 					},
 				},
 			},
 			"action_category": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"priority": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 			},
 		},
 	}
 }
 
-func getConditions(d *schema.ResourceData) []preciseprotection_rules.Condition {
-	var conditionOpts []preciseprotection_rules.Condition
+// preciseCondition is a rule condition, which can reference a reference table instead of `contents`
+type preciseCondition struct {
+	Category    string   `json:"category" required:"true"`
+	Index       string   `json:"index,omitempty"`
+	Logic       int      `json:"logic" required:"true"`
+	Contents    []string `json:"contents,omitempty"`
+	ValueListID string   `json:"value_list_id,omitempty"`
+}
+
+type preciseRuleOpts struct {
+	Name       string                         `json:"name" required:"true"`
+	Time       bool                           `json:"time,omitempty"`
+	Start      int64                          `json:"start,omitempty"`
+	End        int64                          `json:"end,omitempty"`
+	Conditions []preciseCondition             `json:"conditions" required:"true"`
+	Action     preciseprotection_rules.Action `json:"action" required:"true"`
+	Priority   *int                           `json:"priority,omitempty"`
+}
+
+func (opts preciseRuleOpts) ToPreciseCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// validatePreciseConditions requires exactly one of `contents` and `reference_table_id` in every condition
+func validatePreciseConditions(d *schema.ResourceDiff, _ interface{}) error {
+	for i, v := range d.Get("conditions").([]interface{}) {
+		tableKey := fmt.Sprintf("conditions.%d.reference_table_id", i)
+		if !d.NewValueKnown(tableKey) {
+			continue
+		}
+		cond := v.(map[string]interface{})
+		hasContents := len(cond["contents"].([]interface{})) > 0
+		hasTable := cond["reference_table_id"].(string) != ""
+		if hasContents == hasTable {
+			return fmt.Errorf("exactly one of `contents` and `reference_table_id` must be set in condition %d", i)
+		}
+	}
+	return nil
+}
+
+func getConditions(d *schema.ResourceData) []preciseCondition {
+	var conditionOpts []preciseCondition
 
 	conditions := d.Get("conditions").([]interface{})
 	for _, v := range conditions {
@@ -114,11 +150,12 @@ func getConditions(d *schema.ResourceData) []preciseprotection_rules.Condition {
 			contents[i] = v.(string)
 		}
 
-		condition := preciseprotection_rules.Condition{
-			Category: cond["category"].(string),
-			Index:    cond["index"].(string),
-			Logic:    cond["logic"].(int),
-			Contents: contents,
+		condition := preciseCondition{
+			Category:    cond["category"].(string),
+			Index:       cond["index"].(string),
+			Logic:       cond["logic"].(int),
+			Contents:    contents,
+			ValueListID: cond["reference_table_id"].(string),
 		}
 		conditionOpts = append(conditionOpts, condition)
 	}
@@ -136,16 +173,9 @@ func getPreciseAction(d *schema.ResourceData) preciseprotection_rules.Action {
 	return action
 }
 
-func resourceWafPreciseProtectionRuleV1Create(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*cfg.Config)
-
-	wafClient, err := config.WafV1Client(config.GetRegion(d))
-
-	if err != nil {
-		return fmt.Errorf("Error creating OpenTelekomcomCloud WAF Client: %s", err)
-	}
+func getPreciseOpts(d *schema.ResourceData) (preciseRuleOpts, error) {
 	priority := d.Get("priority").(int)
-	createOpts := preciseprotection_rules.CreateOpts{
+	opts := preciseRuleOpts{
 		Name:       d.Get("name").(string),
 		Time:       d.Get("time").(bool),
 		Conditions: getConditions(d),
@@ -156,16 +186,31 @@ func resourceWafPreciseProtectionRuleV1Create(d *schema.ResourceData, meta inter
 	if _, ok := d.GetOk("start"); ok {
 		start, err := strconv.ParseInt(d.Get("start").(string), 10, 64)
 		if err != nil {
-			return fmt.Errorf("Error converting start: %s", err)
+			return opts, fmt.Errorf("Error converting start: %s", err)
 		}
-		createOpts.Start = start
+		opts.Start = start
 	}
-	if _, ok := d.GetOk("cache_control"); ok {
+	if _, ok := d.GetOk("end"); ok {
 		end, err := strconv.ParseInt(d.Get("end").(string), 10, 64)
 		if err != nil {
-			return fmt.Errorf("Error converting end: %s", err)
+			return opts, fmt.Errorf("Error converting end: %s", err)
 		}
-		createOpts.End = end
+		opts.End = end
+	}
+	return opts, nil
+}
+
+func resourceWafPreciseProtectionRuleV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomcomCloud WAF Client: %s", err)
+	}
+	createOpts, err := getPreciseOpts(d)
+	if err != nil {
+		return err
 	}
 
 	policy_id := d.Get("policy_id").(string)
@@ -187,7 +232,8 @@ func resourceWafPreciseProtectionRuleV1Read(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error creating OpenTelekomCloud WAF client: %s", err)
 	}
 	policy_id := d.Get("policy_id").(string)
-	n, err := preciseprotection_rules.Get(wafClient, policy_id, d.Id()).Extract()
+	result := preciseprotection_rules.Get(wafClient, policy_id, d.Id())
+	n, err := result.Extract()
 
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
@@ -198,6 +244,14 @@ func resourceWafPreciseProtectionRuleV1Read(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error retrieving OpenTelekomCloud Waf Precise Protection Rule: %s", err)
 	}
 
+	// reference tables are missing in the SDK
+	var references struct {
+		Conditions []preciseCondition `json:"conditions"`
+	}
+	if err := result.ExtractInto(&references); err != nil {
+		return fmt.Errorf("Error extracting OpenTelekomCloud Waf Precise Protection Rule conditions: %s", err)
+	}
+
 	d.SetId(n.Id)
 	d.Set("policy_id", n.PolicyID)
 	d.Set("name", n.Name)
@@ -212,6 +266,9 @@ func resourceWafPreciseProtectionRuleV1Read(d *schema.ResourceData, meta interfa
 		conditions[i]["index"] = condition.Index
 		conditions[i]["logic"] = condition.Logic
 		conditions[i]["contents"] = condition.Contents
+		if i < len(references.Conditions) {
+			conditions[i]["reference_table_id"] = references.Conditions[i].ValueListID
+		}
 	}
 	d.Set("conditions", conditions)
 	d.Set("action_category", n.Action.Category)
@@ -220,6 +277,31 @@ func resourceWafPreciseProtectionRuleV1Read(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+func resourceWafPreciseProtectionRuleV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	opts, err := getPreciseOpts(d)
+	if err != nil {
+		return err
+	}
+	updateOpts, err := opts.ToPreciseCreateMap()
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] updateOpts: %#v", updateOpts)
+
+	policy_id := d.Get("policy_id").(string)
+	if err := updateRule(wafClient, "custom", policy_id, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud WAF Precise Protection Rule: %s", err)
+	}
+
+	return resourceWafPreciseProtectionRuleV1Read(d, meta)
+}
+
 func resourceWafPreciseProtectionRuleV1Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	wafClient, err := config.WafV1Client(config.GetRegion(d))
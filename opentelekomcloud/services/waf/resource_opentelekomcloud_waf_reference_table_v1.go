@@ -0,0 +1,142 @@
+package waf
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceWafReferenceTableV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWafReferenceTableV1Create,
+		Read:   resourceWafReferenceTableV1Read,
+		Update: resourceWafReferenceTableV1Update,
+		Delete: resourceWafReferenceTableV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"url", "user-agent", "ip", "params", "cookie", "referer", "header",
+				}, false),
+			},
+			"conditions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 30,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getReferenceTableOpts(d *schema.ResourceData) referenceTableOpts {
+	rawValues := d.Get("conditions").([]interface{})
+	values := make([]string, len(rawValues))
+	for i, v := range rawValues {
+		values[i] = v.(string)
+	}
+	return referenceTableOpts{
+		Name:        d.Get("name").(string),
+		Type:        d.Get("type").(string),
+		Values:      values,
+		Description: d.Get("description").(string),
+	}
+}
+
+func resourceWafReferenceTableV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	createOpts := getReferenceTableOpts(d)
+	log.Printf("[DEBUG] CreateOpts: %#v", createOpts)
+
+	table, err := createReferenceTable(wafClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Reference Table: %s", err)
+	}
+	d.SetId(table.ID)
+
+	return resourceWafReferenceTableV1Read(d, meta)
+}
+
+func resourceWafReferenceTableV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF client: %s", err)
+	}
+
+	table, err := getReferenceTable(wafClient, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "Error retrieving OpenTelekomCloud WAF Reference Table")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", table.Name),
+		d.Set("type", table.Type),
+		d.Set("conditions", table.Values),
+		d.Set("description", table.Description),
+		d.Set("creation_time", table.Timestamp),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceWafReferenceTableV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	updateOpts := getReferenceTableOpts(d)
+	log.Printf("[DEBUG] updateOpts: %#v", updateOpts)
+
+	if err := updateReferenceTable(wafClient, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud WAF Reference Table: %s", err)
+	}
+
+	return resourceWafReferenceTableV1Read(d, meta)
+}
+
+func resourceWafReferenceTableV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF client: %s", err)
+	}
+
+	if err := deleteReferenceTable(wafClient, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "Error deleting OpenTelekomCloud WAF Reference Table")
+	}
+
+	d.SetId("")
+	return nil
+}
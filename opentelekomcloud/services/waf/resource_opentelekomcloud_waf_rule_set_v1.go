@@ -0,0 +1,566 @@
+package waf
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/preciseprotection_rules"
+	"gopkg.in/yaml.v2"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// ruleSetTypes maps rule types of the rule set document to the policy API rule types
+var ruleSetTypes = map[string]string{
+	"geoip":        "geoip",
+	"precise":      "custom",
+	"whiteblackip": "whiteblackip",
+}
+
+type ruleSetCondition struct {
+	Category         string   `json:"category" yaml:"category"`
+	Index            string   `json:"index,omitempty" yaml:"index"`
+	Logic            int      `json:"logic" yaml:"logic"`
+	Contents         []string `json:"contents,omitempty" yaml:"contents"`
+	ReferenceTableID string   `json:"reference_table_id,omitempty" yaml:"reference_table_id"`
+}
+
+type ruleSetRule struct {
+	Key        string             `json:"key" yaml:"key"`
+	Type       string             `json:"type" yaml:"type"`
+	Name       string             `json:"name,omitempty" yaml:"name"`
+	Addr       string             `json:"addr,omitempty" yaml:"addr"`
+	GeoIP      []string           `json:"geoip,omitempty" yaml:"geoip"`
+	White      *int               `json:"white,omitempty" yaml:"white"`
+	Conditions []ruleSetCondition `json:"conditions,omitempty" yaml:"conditions"`
+	Action     string             `json:"action,omitempty" yaml:"action"`
+	Priority   *int               `json:"priority,omitempty" yaml:"priority"`
+}
+
+func ResourceWafRuleSetV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWafRuleSetV1Create,
+		Read:   resourceWafRuleSetV1Read,
+		Update: resourceWafRuleSetV1Update,
+		Delete: resourceWafRuleSetV1Delete,
+
+		CustomizeDiff: resourceWafRuleSetV1CustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRuleSet,
+				StateFunc:    normalizeRuleSet,
+			},
+			"remove_unmanaged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rule_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"unmanaged_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// parseRuleSet parses JSON or YAML list of rules, validates them and fills in defaults,
+// returned rules are sorted by key
+func parseRuleSet(doc string) ([]ruleSetRule, error) {
+	var rules []ruleSetRule
+	if err := yaml.UnmarshalStrict([]byte(doc), &rules); err != nil {
+		return nil, fmt.Errorf("error parsing rule set: %s", err)
+	}
+
+	keys := make(map[string]bool)
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Key == "" {
+			return nil, fmt.Errorf("rule #%d: `key` is required", i)
+		}
+		if keys[rule.Key] {
+			return nil, fmt.Errorf("rule %q: duplicate key", rule.Key)
+		}
+		keys[rule.Key] = true
+
+		if err := validateRuleSetRule(rule); err != nil {
+			return nil, fmt.Errorf("rule %q: %s", rule.Key, err)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Key < rules[j].Key })
+	return rules, nil
+}
+
+func validateRuleSetRule(rule *ruleSetRule) error {
+	zero := 0
+	switch rule.Type {
+	case "whiteblackip":
+		if rule.Addr == "" {
+			return fmt.Errorf("`addr` is required for whiteblackip rules")
+		}
+		if rule.Name != "" || len(rule.GeoIP) > 0 || len(rule.Conditions) > 0 || rule.Action != "" || rule.Priority != nil {
+			return fmt.Errorf("only `addr` and `white` can be set for whiteblackip rules")
+		}
+		if rule.White == nil {
+			rule.White = &zero
+		}
+		if *rule.White != 0 && *rule.White != 1 {
+			return fmt.Errorf("`white` must be 0 or 1 for whiteblackip rules")
+		}
+	case "geoip":
+		if len(rule.GeoIP) == 0 {
+			return fmt.Errorf("`geoip` is required for geoip rules")
+		}
+		if rule.Addr != "" || len(rule.Conditions) > 0 || rule.Action != "" || rule.Priority != nil {
+			return fmt.Errorf("only `name`, `geoip` and `white` can be set for geoip rules")
+		}
+		if rule.White == nil {
+			rule.White = &zero
+		}
+		if *rule.White < 0 || *rule.White > 2 {
+			return fmt.Errorf("`white` must be 0, 1 or 2 for geoip rules")
+		}
+		sort.Strings(rule.GeoIP)
+	case "precise":
+		if rule.Name == "" || len(rule.Conditions) == 0 {
+			return fmt.Errorf("`name` and `conditions` are required for precise rules")
+		}
+		if rule.Action != "block" && rule.Action != "pass" {
+			return fmt.Errorf("`action` must be block or pass for precise rules")
+		}
+		if rule.Addr != "" || len(rule.GeoIP) > 0 || rule.White != nil {
+			return fmt.Errorf("only `name`, `conditions`, `action` and `priority` can be set for precise rules")
+		}
+		for _, condition := range rule.Conditions {
+			if len(condition.Contents) == 0 && condition.ReferenceTableID == "" {
+				return fmt.Errorf("either `contents` or `reference_table_id` is required for conditions")
+			}
+		}
+		if rule.Priority == nil {
+			rule.Priority = &zero
+		}
+	default:
+		return fmt.Errorf("unsupported rule type %q, expected one of whiteblackip, geoip or precise", rule.Type)
+	}
+	return nil
+}
+
+func validateRuleSet(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseRuleSet(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid rule set: %s", k, err))
+	}
+	return
+}
+
+func marshalRuleSet(rules []ruleSetRule) (string, error) {
+	if rules == nil {
+		rules = []ruleSetRule{}
+	}
+	b, err := json.Marshal(rules)
+	return string(b), err
+}
+
+// normalizeRuleSet stores rule set as JSON with defaults filled in, so YAML and JSON documents are compared
+func normalizeRuleSet(v interface{}) string {
+	rules, err := parseRuleSet(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+	doc, err := marshalRuleSet(rules)
+	if err != nil {
+		return v.(string)
+	}
+	return doc
+}
+
+func ruleSetRuleBody(rule ruleSetRule) interface{} {
+	switch rule.Type {
+	case "whiteblackip":
+		return map[string]interface{}{
+			"addr":  rule.Addr,
+			"white": *rule.White,
+		}
+	case "geoip":
+		return geoRuleOpts{
+			Name:  rule.Name,
+			GeoIP: strings.Join(rule.GeoIP, "|"),
+			White: *rule.White,
+		}
+	default:
+		conditions := make([]preciseCondition, len(rule.Conditions))
+		for i, condition := range rule.Conditions {
+			conditions[i] = preciseCondition{
+				Category:    condition.Category,
+				Index:       condition.Index,
+				Logic:       condition.Logic,
+				Contents:    condition.Contents,
+				ValueListID: condition.ReferenceTableID,
+			}
+		}
+		return preciseRuleOpts{
+			Name:       rule.Name,
+			Conditions: conditions,
+			Action:     preciseprotection_rules.Action{Category: rule.Action},
+			Priority:   rule.Priority,
+		}
+	}
+}
+
+// flattenPolicyRule builds rule set rule from the rule on the policy, keeping key and type of the configured one
+func flattenPolicyRule(configured ruleSetRule, remote *policyRule) ruleSetRule {
+	rule := ruleSetRule{
+		Key:  configured.Key,
+		Type: configured.Type,
+	}
+	switch rule.Type {
+	case "whiteblackip":
+		white := remote.White
+		rule.Addr = remote.Addr
+		rule.White = &white
+	case "geoip":
+		white := remote.White
+		// name is generated when not set
+		if configured.Name != "" {
+			rule.Name = remote.Name
+		}
+		if remote.GeoIP != "" {
+			rule.GeoIP = strings.Split(remote.GeoIP, "|")
+			sort.Strings(rule.GeoIP)
+		}
+		rule.White = &white
+	default:
+		priority := remote.Priority
+		rule.Name = remote.Name
+		rule.Action = remote.Action.Category
+		rule.Priority = &priority
+		for _, condition := range remote.Conditions {
+			rule.Conditions = append(rule.Conditions, ruleSetCondition{
+				Category:         condition.Category,
+				Index:            condition.Index,
+				Logic:            condition.Logic,
+				Contents:         condition.Contents,
+				ReferenceTableID: condition.ValueListID,
+			})
+		}
+	}
+	return rule
+}
+
+// applyRuleSet deletes, updates and creates rules on the policy to get from `oldRules` to `newRules`,
+// `ids` is updated with IDs of the rules existing on the policy
+func applyRuleSet(client *golangsdk.ServiceClient, policyID string, oldRules, newRules []ruleSetRule, ids map[string]string) error {
+	oldByKey := make(map[string]ruleSetRule)
+	for _, rule := range oldRules {
+		oldByKey[rule.Key] = rule
+	}
+	newByKey := make(map[string]ruleSetRule)
+	for _, rule := range newRules {
+		newByKey[rule.Key] = rule
+	}
+
+	// rules can't change their type, those are deleted together with removed rules
+	for _, rule := range oldRules {
+		id, ok := ids[rule.Key]
+		if !ok {
+			continue
+		}
+		if newRule, ok := newByKey[rule.Key]; ok && newRule.Type == rule.Type {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting WAF %s rule %s (%s)", rule.Type, rule.Key, id)
+		if err := deletePolicyRule(client, ruleSetTypes[rule.Type], policyID, id); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmt.Errorf("error deleting WAF %s rule %s: %s", rule.Type, rule.Key, err)
+			}
+		}
+		delete(ids, rule.Key)
+	}
+
+	for _, rule := range newRules {
+		body := ruleSetRuleBody(rule)
+		if id, ok := ids[rule.Key]; ok {
+			if reflect.DeepEqual(oldByKey[rule.Key], rule) {
+				continue
+			}
+			log.Printf("[DEBUG] Updating WAF %s rule %s (%s): %#v", rule.Type, rule.Key, id, body)
+			if err := updateRule(client, ruleSetTypes[rule.Type], policyID, id, body); err != nil {
+				return fmt.Errorf("error updating WAF %s rule %s: %s", rule.Type, rule.Key, err)
+			}
+			continue
+		}
+		log.Printf("[DEBUG] Creating WAF %s rule %s: %#v", rule.Type, rule.Key, body)
+		created, err := createPolicyRule(client, ruleSetTypes[rule.Type], policyID, body)
+		if err != nil {
+			return fmt.Errorf("error creating WAF %s rule %s: %s", rule.Type, rule.Key, err)
+		}
+		ids[rule.Key] = created.ID
+	}
+	return nil
+}
+
+// listRuleSetPolicyRules lists rules of the supported types existing on the policy,
+// keyed by the rule set rule type and rule ID
+func listRuleSetPolicyRules(client *golangsdk.ServiceClient, policyID string) (map[string]map[string]policyRule, error) {
+	rules := make(map[string]map[string]policyRule)
+	for ruleType, policyRuleType := range ruleSetTypes {
+		list, err := listPolicyRules(client, policyRuleType, policyID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing WAF %s rules: %s", ruleType, err)
+		}
+		byID := make(map[string]policyRule, len(list))
+		for _, rule := range list {
+			byID[rule.ID] = rule
+		}
+		rules[ruleType] = byID
+	}
+	return rules, nil
+}
+
+// unmanagedRules returns rules of the `remote` rules missing in the `ids`, sorted by type and ID
+func unmanagedRules(remote map[string]map[string]policyRule, ids map[string]string) []map[string]interface{} {
+	managed := make(map[string]bool)
+	for _, id := range ids {
+		managed[id] = true
+	}
+
+	types := make([]string, 0, len(remote))
+	for ruleType := range remote {
+		types = append(types, ruleType)
+	}
+	sort.Strings(types)
+
+	unmanaged := make([]map[string]interface{}, 0)
+	for _, ruleType := range types {
+		ruleIDs := make([]string, 0, len(remote[ruleType]))
+		for id := range remote[ruleType] {
+			if !managed[id] {
+				ruleIDs = append(ruleIDs, id)
+			}
+		}
+		sort.Strings(ruleIDs)
+		for _, id := range ruleIDs {
+			unmanaged = append(unmanaged, map[string]interface{}{
+				"type": ruleType,
+				"id":   id,
+			})
+		}
+	}
+	return unmanaged
+}
+
+func expandRuleIDs(d *schema.ResourceData) map[string]string {
+	ids := make(map[string]string)
+	for key, id := range d.Get("rule_ids").(map[string]interface{}) {
+		ids[key] = id.(string)
+	}
+	return ids
+}
+
+func resourceWafRuleSetV1CustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.Get("remove_unmanaged").(bool) {
+		return nil
+	}
+	if len(d.Get("unmanaged_rules").([]interface{})) > 0 {
+		return d.SetNew("unmanaged_rules", []interface{}{})
+	}
+	return nil
+}
+
+func resourceWafRuleSetV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	rules, err := parseRuleSet(d.Get("rules").(string))
+	if err != nil {
+		return err
+	}
+
+	policyID := d.Get("policy_id").(string)
+	d.SetId(policyID)
+
+	ids := make(map[string]string)
+	applyErr := applyRuleSet(wafClient, policyID, nil, rules, ids)
+	if err := d.Set("rule_ids", ids); err != nil {
+		return err
+	}
+	if applyErr != nil {
+		return fmt.Errorf("Error applying OpenTelekomCloud WAF Rule Set: %s", applyErr)
+	}
+
+	if d.Get("remove_unmanaged").(bool) {
+		if err := removeUnmanagedRules(wafClient, policyID, ids); err != nil {
+			return err
+		}
+	}
+
+	return resourceWafRuleSetV1Read(d, meta)
+}
+
+func resourceWafRuleSetV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF client: %s", err)
+	}
+
+	if _, err := policies.Get(wafClient, d.Id()).Extract(); err != nil {
+		return common.CheckDeleted(d, err, "Error retrieving OpenTelekomCloud WAF Policy")
+	}
+
+	rules, err := parseRuleSet(d.Get("rules").(string))
+	if err != nil {
+		return err
+	}
+	ids := expandRuleIDs(d)
+
+	remote, err := listRuleSetPolicyRules(wafClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenTelekomCloud WAF Policy rules: %s", err)
+	}
+
+	// rebuild the rule set from the rules on the policy to detect drift
+	var current []ruleSetRule
+	currentIDs := make(map[string]string)
+	for _, rule := range rules {
+		id, ok := ids[rule.Key]
+		if !ok {
+			continue
+		}
+		remoteRule, ok := remote[rule.Type][id]
+		if !ok {
+			log.Printf("[WARN] WAF %s rule %s (%s) not found", rule.Type, rule.Key, id)
+			continue
+		}
+		current = append(current, flattenPolicyRule(rule, &remoteRule))
+		currentIDs[rule.Key] = id
+	}
+	doc, err := marshalRuleSet(current)
+	if err != nil {
+		return err
+	}
+
+	unmanaged := unmanagedRules(remote, currentIDs)
+	if len(unmanaged) > 0 {
+		log.Printf("[WARN] WAF policy %s has %d rules not managed by the rule set", d.Id(), len(unmanaged))
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("policy_id", d.Id()),
+		d.Set("rules", doc),
+		d.Set("rule_ids", currentIDs),
+		d.Set("unmanaged_rules", unmanaged),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceWafRuleSetV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	if d.HasChange("rules") {
+		oldDoc, newDoc := d.GetChange("rules")
+		oldRules, err := parseRuleSet(oldDoc.(string))
+		if err != nil {
+			return err
+		}
+		newRules, err := parseRuleSet(newDoc.(string))
+		if err != nil {
+			return err
+		}
+
+		ids := expandRuleIDs(d)
+		applyErr := applyRuleSet(wafClient, d.Id(), oldRules, newRules, ids)
+		if err := d.Set("rule_ids", ids); err != nil {
+			return err
+		}
+		if applyErr != nil {
+			return fmt.Errorf("Error applying OpenTelekomCloud WAF Rule Set: %s", applyErr)
+		}
+	}
+
+	if d.Get("remove_unmanaged").(bool) {
+		if err := removeUnmanagedRules(wafClient, d.Id(), expandRuleIDs(d)); err != nil {
+			return err
+		}
+	}
+
+	return resourceWafRuleSetV1Read(d, meta)
+}
+
+func removeUnmanagedRules(client *golangsdk.ServiceClient, policyID string, ids map[string]string) error {
+	remote, err := listRuleSetPolicyRules(client, policyID)
+	if err != nil {
+		return err
+	}
+	for _, rule := range unmanagedRules(remote, ids) {
+		ruleType, id := rule["type"].(string), rule["id"].(string)
+		log.Printf("[DEBUG] Deleting unmanaged WAF %s rule %s", ruleType, id)
+		if err := deletePolicyRule(client, ruleSetTypes[ruleType], policyID, id); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmt.Errorf("Error deleting unmanaged WAF %s rule %s: %s", ruleType, id, err)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceWafRuleSetV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF client: %s", err)
+	}
+
+	rules, err := parseRuleSet(d.Get("rules").(string))
+	if err != nil {
+		return err
+	}
+
+	// deleting all managed rules, unmanaged ones are kept
+	ids := expandRuleIDs(d)
+	if err := applyRuleSet(wafClient, d.Id(), rules, nil, ids); err != nil {
+		return fmt.Errorf("Error deleting OpenTelekomCloud WAF Rule Set: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -16,9 +16,10 @@ func ResourceWafWebTamperProtectionRuleV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWafWebTamperProtectionRuleV1Create,
 		Read:   resourceWafWebTamperProtectionRuleV1Read,
+		Update: resourceWafWebTamperProtectionRuleV1Update,
 		Delete: resourceWafWebTamperProtectionRuleV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceWafRuleImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -35,12 +36,10 @@ func ResourceWafWebTamperProtectionRuleV1() *schema.Resource {
 			"hostname": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 		},
 	}
@@ -98,6 +97,39 @@ func resourceWafWebTamperProtectionRuleV1Read(d *schema.ResourceData, meta inter
 	return nil
 }
 
+// resourceWafWebTamperProtectionRuleV1Update replaces the rule as there is no API to modify it,
+// the new rule is created before the old one is deleted so the page stays protected
+func resourceWafWebTamperProtectionRuleV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	wafClient, err := config.WafV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud WAF Client: %s", err)
+	}
+
+	createOpts := webtamperprotection_rules.CreateOpts{
+		Hostname: d.Get("hostname").(string),
+		Url:      d.Get("url").(string),
+	}
+
+	policy_id := d.Get("policy_id").(string)
+	rule, err := webtamperprotection_rules.Create(wafClient, policy_id, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenTelekomcomCloud WAF Web Tamper Protection Rule: %s", err)
+	}
+	log.Printf("[DEBUG] Waf web tamper protection rule %s replaced with %s", d.Id(), rule.Id)
+
+	oldID := d.Id()
+	d.SetId(rule.Id)
+	err = webtamperprotection_rules.Delete(wafClient, policy_id, oldID).ExtractErr()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("Error deleting replaced OpenTelekomCloud WAF Web Tamper Protection Rule %s: %s", oldID, err)
+		}
+	}
+
+	return resourceWafWebTamperProtectionRuleV1Read(d, meta)
+}
+
 func resourceWafWebTamperProtectionRuleV1Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	wafClient, err := config.WafV1Client(config.GetRegion(d))
@@ -19,7 +19,7 @@ func ResourceWafWhiteBlackIpRuleV1() *schema.Resource {
 		Update: resourceWafWhiteBlackIpRuleV1Update,
 		Delete: resourceWafWhiteBlackIpRuleV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceWafRuleImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{